@@ -0,0 +1,173 @@
+package tview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// Aligned is a wrapper which positions a single primitive within its inner
+// rectangle instead of letting it fill the entire space, e.g. to center a
+// small form in a large area. The child is given its preferred size, which it
+// reports by implementing PreferredSizer, or the size set with SetSize(). If
+// neither is available, the child fills the respective dimension.
+//
+// Horizontal alignment is one of AlignLeft, AlignCenter, or AlignRight and
+// vertical alignment is one of AlignTop, AlignCenter, or AlignBottom.
+type Aligned struct {
+	*Box
+
+	// The wrapped primitive.
+	primitive Primitive
+
+	// The horizontal and vertical alignment of the child.
+	horizontal, vertical int
+
+	// The size of the child if it doesn't report a preferred size. 0 means
+	// the child fills the respective dimension.
+	width, height int
+}
+
+// NewAligned returns a new wrapper which positions the given primitive
+// according to the given horizontal and vertical alignment.
+func NewAligned(primitive Primitive, horizontal, vertical int) *Aligned {
+	return &Aligned{
+		Box:        NewBox(),
+		primitive:  primitive,
+		horizontal: horizontal,
+		vertical:   vertical,
+	}
+}
+
+// SetAlignment sets the horizontal (AlignLeft, AlignCenter, AlignRight) and
+// vertical (AlignTop, AlignCenter, AlignBottom) alignment of the child.
+func (a *Aligned) SetAlignment(horizontal, vertical int) *Aligned {
+	a.horizontal, a.vertical = horizontal, vertical
+	return a
+}
+
+// SetSize sets the size of the child, used if the child does not implement
+// PreferredSizer. A value of 0 lets the child fill the respective dimension.
+func (a *Aligned) SetSize(width, height int) *Aligned {
+	a.width, a.height = width, height
+	return a
+}
+
+// SetPrimitive replaces the wrapped primitive.
+func (a *Aligned) SetPrimitive(primitive Primitive) *Aligned {
+	a.primitive = primitive
+	return a
+}
+
+// GetPrimitive returns the wrapped primitive.
+func (a *Aligned) GetPrimitive() Primitive {
+	return a.primitive
+}
+
+// GetPreferredSize returns the preferred size of the wrapped primitive plus
+// the space taken by this wrapper's border and padding, so that Aligned
+// wrappers may be nested.
+func (a *Aligned) GetPreferredSize() (width, height int) {
+	width, height = a.childSize()
+	top, bottom, left, right := a.borderWidths()
+	if width > 0 {
+		width += a.paddingLeft + a.paddingRight + left + right
+	}
+	if height > 0 {
+		height += a.paddingTop + a.paddingBottom + top + bottom
+	}
+	return
+}
+
+// childSize returns the preferred size of the child.
+func (a *Aligned) childSize() (width, height int) {
+	if sizer, ok := a.primitive.(PreferredSizer); ok {
+		return sizer.GetPreferredSize()
+	}
+	return a.width, a.height
+}
+
+// Draw draws this primitive onto the screen.
+func (a *Aligned) Draw(screen tcell.Screen) {
+	if !a.Box.DrawForSubclass(screen, a) || a.primitive == nil {
+		return
+	}
+	x, y, width, height := a.GetInnerRect()
+	childWidth, childHeight := a.childSize()
+	if childWidth <= 0 || childWidth > width {
+		childWidth = width
+	}
+	if childHeight <= 0 || childHeight > height {
+		childHeight = height
+	}
+
+	switch a.horizontal {
+	case AlignCenter:
+		x += (width - childWidth) / 2
+	case AlignRight:
+		x += width - childWidth
+	}
+	switch a.vertical {
+	case AlignCenter:
+		y += (height - childHeight) / 2
+	case AlignBottom:
+		y += height - childHeight
+	}
+
+	a.primitive.SetRect(x, y, childWidth, childHeight)
+	a.primitive.Draw(screen)
+}
+
+// Focus is called when this primitive receives focus.
+func (a *Aligned) Focus(delegate func(p Primitive)) {
+	if a.primitive != nil {
+		delegate(a.primitive)
+	} else {
+		a.Box.Focus(delegate)
+	}
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (a *Aligned) HasFocus() bool {
+	if a.primitive == nil {
+		return a.Box.HasFocus()
+	}
+	return a.primitive.HasFocus()
+}
+
+// containedPrimitives returns the aligned primitive. See primitiveContainer.
+func (a *Aligned) containedPrimitives(visibleOnly bool) []Primitive {
+	if a.primitive == nil {
+		return nil
+	}
+	return []Primitive{a.primitive}
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (a *Aligned) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+	return a.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		if !a.InRect(event.Position()) {
+			return false, nil
+		}
+
+		// Pass mouse events on to the wrapped primitive.
+		if a.primitive != nil {
+			return a.primitive.MouseHandler()(action, event, setFocus)
+		}
+
+		return false, nil
+	})
+}
+
+// InputHandler returns the handler for this primitive.
+func (a *Aligned) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
+	return a.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		if a.primitive == nil {
+			return
+		}
+		if a.primitive.HasFocus() {
+			if handler := a.primitive.InputHandler(); handler != nil {
+				handler(event, setFocus)
+				return
+			}
+		}
+	})
+}
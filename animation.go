@@ -0,0 +1,364 @@
+package tview
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// animationFrameInterval is the time between two frames of animations driven
+// by an Animator.
+const animationFrameInterval = 16 * time.Millisecond
+
+// EasingFunc maps the linear progress of an animation, from 0 to 1, to the
+// progress passed to the animation's frame function. It should return 0 for 0
+// and 1 for 1.
+type EasingFunc func(t float64) float64
+
+// Standard easing functions.
+var (
+	// EaseLinear progresses at a constant speed.
+	EaseLinear EasingFunc = func(t float64) float64 {
+		return t
+	}
+
+	// EaseIn starts slowly and accelerates.
+	EaseIn EasingFunc = func(t float64) float64 {
+		return t * t
+	}
+
+	// EaseOut starts quickly and decelerates.
+	EaseOut EasingFunc = func(t float64) float64 {
+		return 1 - (1-t)*(1-t)
+	}
+
+	// EaseInOut accelerates during the first half and decelerates during the
+	// second half.
+	EaseInOut EasingFunc = func(t float64) float64 {
+		return (1 - math.Cos(math.Pi*t)) / 2
+	}
+)
+
+// Animation is a tween driven by an Animator. Its frame function is called
+// with the eased progress, from 0 to 1, for every frame until the duration
+// has passed.
+type Animation struct {
+	// The duration of the animation.
+	Duration time.Duration
+
+	// The easing function. EaseLinear is used if nil.
+	Easing EasingFunc
+
+	// The function called for every frame with the eased progress. The last
+	// frame is always called with a progress of 1. May be nil.
+	OnFrame func(progress float64)
+
+	// The function called after the last frame. May be nil.
+	OnDone func()
+
+	// The time the animation was started.
+	start time.Time
+}
+
+// Animator drives animations. Its Tick() function advances all running
+// animations to a point in time. If an application is set with
+// SetApplication(), the animator ticks itself in the application's main
+// goroutine at about 60 frames per second while animations are running,
+// redrawing the screen after each frame. Otherwise, Tick() must be called by
+// the user.
+//
+// Boxes use DefaultAnimator (see Box.Animate()), which is ticked by the
+// application most recently started with Application.Run().
+type Animator struct {
+	sync.Mutex
+
+	// The running animations.
+	animations []*Animation
+
+	// The application used to schedule frames, and whether a goroutine
+	// currently schedules them.
+	app     *Application
+	driving bool
+}
+
+// DefaultAnimator is the animator used by Box.Animate().
+var DefaultAnimator = NewAnimator()
+
+// NewAnimator returns a new animator without any animations.
+func NewAnimator() *Animator {
+	return &Animator{}
+}
+
+// SetApplication sets the application whose main goroutine runs the frames
+// of the animations. Set to nil to tick the animator manually.
+func (a *Animator) SetApplication(app *Application) *Animator {
+	a.Lock()
+	defer a.Unlock()
+	a.app = app
+	a.drive()
+	return a
+}
+
+// hasApplication returns whether the animator ticks itself, i.e. whether an
+// application was set.
+func (a *Animator) hasApplication() bool {
+	a.Lock()
+	defer a.Unlock()
+	return a.app != nil
+}
+
+// Start starts the given animation and returns it. The first frame is
+// drawn with the next tick.
+func (a *Animator) Start(animation *Animation) *Animation {
+	a.Lock()
+	defer a.Unlock()
+	animation.start = time.Now()
+	a.animations = append(a.animations, animation)
+	a.drive()
+	return animation
+}
+
+// Stop removes the given animation without calling any of its functions.
+func (a *Animator) Stop(animation *Animation) {
+	a.Lock()
+	defer a.Unlock()
+	for index, running := range a.animations {
+		if running == animation {
+			a.animations = append(a.animations[:index], a.animations[index+1:]...)
+			return
+		}
+	}
+}
+
+// IsActive returns whether any animations are running.
+func (a *Animator) IsActive() bool {
+	a.Lock()
+	defer a.Unlock()
+	return len(a.animations) > 0
+}
+
+// Tick advances all running animations to the given point in time, calling
+// their frame functions, and removes the finished ones after calling their
+// done functions. It returns whether any animations are still running.
+func (a *Animator) Tick(now time.Time) bool {
+	a.Lock()
+	animations := append([]*Animation(nil), a.animations...)
+	a.Unlock()
+
+	for _, animation := range animations {
+		progress := 1.0
+		if animation.Duration > 0 {
+			progress = float64(now.Sub(animation.start)) / float64(animation.Duration)
+		}
+		if progress < 0 {
+			progress = 0
+		}
+		finished := progress >= 1
+		if finished {
+			progress = 1
+		}
+		if animation.OnFrame != nil {
+			eased := progress
+			if animation.Easing != nil && !finished {
+				eased = animation.Easing(progress)
+			}
+			animation.OnFrame(eased)
+		}
+		if finished {
+			a.Stop(animation)
+			if animation.OnDone != nil {
+				animation.OnDone()
+			}
+		}
+	}
+
+	return a.IsActive()
+}
+
+// drive starts a goroutine which schedules frames in the application's main
+// goroutine while animations are running, if there is an application and no
+// such goroutine yet. The animator must be locked when calling this function.
+func (a *Animator) drive() {
+	if a.app == nil || a.driving || len(a.animations) == 0 {
+		return
+	}
+	a.driving = true
+	go func() {
+		ticker := time.NewTicker(animationFrameInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			a.Lock()
+			app := a.app
+			if len(a.animations) == 0 || app == nil || app.runContext.Err() != nil {
+				a.driving = false
+				a.Unlock()
+				return
+			}
+			a.Unlock()
+			app.QueueUpdateDraw(func() {
+				a.Tick(time.Now())
+			})
+		}
+	}()
+}
+
+// Animate runs an animation of the given duration on DefaultAnimator. The
+// onFrame function is called for every frame with the progress, from 0 to 1,
+// mapped by the given easing function (EaseLinear if nil). The onDone
+// function is called after the last frame. Either function may be nil. The
+// box is marked as animating (see GetAnimating()) until all of its animations
+// are done.
+//
+// Frames are run in the main goroutine of the application most recently
+// started with Application.Run(), so an application is required. If no
+// application was started yet, the animation skips to its end immediately:
+// onFrame is called with a progress of 1, then onDone is called, and the box
+// is not marked as animating.
+func (b *Box) Animate(duration time.Duration, easing EasingFunc, onFrame func(progress float64), onDone func()) *Box {
+	if !DefaultAnimator.hasApplication() {
+		if onFrame != nil {
+			onFrame(1)
+		}
+		if onDone != nil {
+			onDone()
+		}
+		return b
+	}
+	b.runningAnimations++
+	b.SetAnimating(true)
+	DefaultAnimator.Start(&Animation{
+		Duration: duration,
+		Easing:   easing,
+		OnFrame:  onFrame,
+		OnDone: func() {
+			b.runningAnimations--
+			if b.runningAnimations <= 0 {
+				b.runningAnimations = 0
+				b.SetAnimating(false)
+			}
+			if onDone != nil {
+				onDone()
+			}
+		},
+	})
+	return b
+}
+
+// Edge is one of the four edges of the screen.
+type Edge int
+
+// The edges of the screen, see Box.SlideIn() and Box.SlideOut().
+const (
+	EdgeTop Edge = iota
+	EdgeBottom
+	EdgeLeft
+	EdgeRight
+)
+
+// slideState describes a running slide-in or slide-out transition.
+type slideState struct {
+	// The screen edge the box slides in from or out to, and whether it
+	// slides out.
+	edge Edge
+	out  bool
+
+	// The eased progress of the transition, from 0 to 1.
+	progress float64
+
+	// The rect the box slides in to or out from, and the rect last applied
+	// by the transition. If the box's rect differs from the latter, it was
+	// changed by a layout or the application and becomes the new target.
+	target, applied [4]int
+
+	// The primitive the box belongs to, as last passed to applySlide(). It
+	// is nil if the transition was never drawn.
+	primitive Primitive
+}
+
+// SlideIn makes the box visible and moves it from beyond the given screen
+// edge to its current position over the given duration (see Animate()). The
+// box is marked as animating while it moves, so its inner rect isn't clamped
+// to the screen. When the box has arrived, an EventSlideIn event with the edge
+// is sent to the evented function (see SetEventedFunc()) and onDone, which
+// may be nil, is called.
+//
+// The box may be part of a layout. Its position is offset whenever it is
+// drawn, relative to the rect it was given last.
+func (b *Box) SlideIn(from Edge, duration time.Duration, onDone func()) *Box {
+	b.SetVisible(true)
+	return b.slide(from, false, duration, onDone)
+}
+
+// SlideOut moves the box from its current position to beyond the given screen
+// edge over the given duration (see Animate()) and hides it (see
+// SetVisible()). When the box has left, its rect is restored, an EventSlideOut
+// event with the edge is sent to the evented function (see SetEventedFunc()),
+// and onDone, which may be nil, is called. See SlideIn() for details.
+func (b *Box) SlideOut(to Edge, duration time.Duration, onDone func()) *Box {
+	return b.slide(to, true, duration, onDone)
+}
+
+// slide starts a slide-in or slide-out transition.
+func (b *Box) slide(edge Edge, out bool, duration time.Duration, onDone func()) *Box {
+	rect := [4]int{b.x, b.y, b.width, b.height}
+	state := &slideState{edge: edge, out: out, target: rect, applied: rect}
+	b.slideState = state
+	return b.Animate(duration, EaseInOut, func(progress float64) {
+		state.progress = progress
+	}, func() {
+		if b.slideState != state {
+			return // A newer transition took over.
+		}
+		b.slideState = nil
+		p := state.primitive
+		if p == nil {
+			p = b
+		}
+		target := state.target
+		setItemRect(p, target[0], target[1], target[2], target[3])
+		event := EventSlideIn
+		if out {
+			b.SetVisible(false)
+			event = EventSlideOut
+		}
+		b.Event(func(f EventedFunc) {
+			f(event, b, edge)
+		})
+		if onDone != nil {
+			onDone()
+		}
+	})
+}
+
+// applySlide moves the box according to a running slide transition. The
+// screen size determines how far the box has to move to leave the screen.
+func (b *Box) applySlide(screen tcell.Screen, p Primitive) {
+	state := b.slideState
+	state.primitive = p
+	if rect := [4]int{b.x, b.y, b.width, b.height}; rect != state.applied {
+		state.target = rect
+	}
+	screenWidth, screenHeight := screen.Size()
+	x, y, width, height := state.target[0], state.target[1], state.target[2], state.target[3]
+
+	// The fraction of the distance to the edge which the box has covered.
+	fraction := state.progress
+	if !state.out {
+		fraction = 1 - fraction
+	}
+	switch state.edge {
+	case EdgeTop:
+		y -= int(fraction*float64(y+height) + 0.5)
+	case EdgeBottom:
+		y += int(fraction*float64(screenHeight-y) + 0.5)
+	case EdgeLeft:
+		x -= int(fraction*float64(x+width) + 0.5)
+	case EdgeRight:
+		x += int(fraction*float64(screenWidth-x) + 0.5)
+	}
+
+	setItemRect(p, x, y, width, height)
+	state.applied = [4]int{b.x, b.y, b.width, b.height}
+}
@@ -3,6 +3,7 @@ package tview
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -107,6 +108,32 @@ type Application struct {
 	beforeDraw func(screen tcell.Screen) bool
 	afterResize func(screen tcell.Screen)
 
+	// An optional callback function which is invoked with the new screen size
+	// when the terminal was resized, before the screen is redrawn.
+	resize func(width, height int)
+
+	// If positive, resize notifications are delayed until no further resize
+	// event arrived for this long.
+	resizeDebounce time.Duration
+
+	// The timer delaying the pending resize notification, if any. It is only
+	// accessed from the event loop.
+	resizeTimer *time.Timer
+
+	// Closed to stop the goroutine redrawing the screen periodically, see
+	// SetTickInterval(). nil if there is none.
+	tickStop chan struct{}
+
+	// Dead-key sequences and the characters they produce, and the characters
+	// of an incomplete sequence typed so far.
+	composeMap    map[string]rune
+	composeBuffer []rune
+
+	// The keys (or, if they are tcell.KeyRune, the runes) which move the focus
+	// to the next and the previous primitive.
+	focusNextKey, focusPrevKey   tcell.Key
+	focusNextRune, focusPrevRune rune
+
 	// An optional callback function which is invoked after the root primitive
 	// was drawn.
 	afterDraw func(screen tcell.Screen)
@@ -133,6 +160,9 @@ type Application struct {
 	mouseDownX, mouseDownY  int              // The position of the mouse when its button was last pressed.
 	lastMouseClick          time.Time        // The time when a mouse button was last clicked.
 	lastMouseButtons        tcell.ButtonMask // The last mouse button state.
+
+	// The current drag-and-drop operation, nil if there is none.
+	drag *dragState
 }
 
 func (a *Application) Close() error {
@@ -172,6 +202,8 @@ func NewApplication() *Application {
 		events:            make(chan tcell.Event, queueSize),
 		updates:           make(chan queuedUpdate, queueSize),
 		screenReplacement: make(chan tcell.Screen, 1),
+		focusNextKey:      tcell.KeyTab,
+		focusPrevKey:      tcell.KeyBacktab,
 	}
 }
 
@@ -265,6 +297,7 @@ func (a *Application) Run() error {
 		lastRedraw  time.Time   // The time the screen was last redrawn.
 		redrawTimer *time.Timer // A timer to schedule the next redraw.
 	)
+	DefaultAnimator.SetApplication(a)
 	a.Lock()
 
 	// Make a screen if there is none yet.
@@ -373,42 +406,12 @@ EventLoop:
 
 			switch event := event.(type) {
 			case *tcell.EventKey:
-				a.RLock()
-				root := a.root
-				inputCapture := a.inputCapture
-				a.RUnlock()
-
-				// Intercept keys.
-				var draw bool
-				if inputCapture != nil {
-					event = inputCapture(event)
-					if event == nil {
+				// Compose dead-key sequences. Incomplete sequences are held back.
+				for _, event := range a.compose(event) {
+					if a.handleKey(event) {
 						a.draw()
-						continue // Don't forward event.
-					}
-					draw = true
-				}
-
-				// Ctrl-C closes the application.
-				if event.Key() == tcell.KeyCtrlC {
-					a.Stop()
-					break
-				}
-
-				// Pass other key events to the root primitive.
-				if root != nil && root.HasFocus() {
-					if handler := root.InputHandler(); handler != nil {
-						handler(event, func(p Primitive) {
-							a.SetFocus(p)
-						})
-						draw = true
 					}
 				}
-
-				// Redraw.
-				if draw {
-					a.draw()
-				}
     case *tcell.EventPaste:
       if a.onPaste != nil {
       a.onPaste(a.screen, event)
@@ -446,10 +449,31 @@ EventLoop:
     if resize != nil {
       resize(screen)
     }
+				a.RLock()
+				debounce := a.resizeDebounce
+				a.RUnlock()
+				if debounce > 0 {
+					// Draw with cached content, notify once resizing settles.
+					if a.resizeTimer != nil {
+						a.resizeTimer.Stop()
+					}
+					a.resizeTimer = time.AfterFunc(debounce, func() {
+						if a.runContext.Err() == nil {
+							a.QueueUpdateDraw(func() {
+								a.notifyScreenResized(screen)
+							})
+						}
+					})
+				} else {
+					a.notifyScreenResized(screen)
+				}
 				a.draw()
 			case *tcell.EventMouse:
+				// The mouse actions still receive a drop's button release
+				// so mouse capturing ends.
+				dropped := a.handleDrag(event)
 				consumed, isMouseDownAction := a.fireMouseActions(event)
-				if consumed {
+				if consumed || dropped {
 					a.draw()
 				}
 				a.lastMouseButtons = event.Buttons()
@@ -844,6 +868,359 @@ func (a *Application) SetAfterResizeFunc(handler func(screen tcell.Screen)) *App
 func (a *Application) GetAfterResizeFunc() func(screen tcell.Screen) {
 	return a.afterResize
 }
+
+// SetResizeFunc installs a callback function which is invoked with the new
+// screen size whenever the terminal was resized, before the screen is redrawn.
+// Afterwards, all primitives of the root primitive's hierarchy which implement
+// Resizable are notified. See also SetResizeDebounce().
+//
+// Provide nil to uninstall the callback function.
+func (a *Application) SetResizeFunc(handler func(width, height int)) *Application {
+	a.Lock()
+	defer a.Unlock()
+	a.resize = handler
+	return a
+}
+
+// GetResizeFunc returns the callback function installed with SetResizeFunc()
+// or nil if none has been installed.
+func (a *Application) GetResizeFunc() func(width, height int) {
+	a.RLock()
+	defer a.RUnlock()
+	return a.resize
+}
+
+// handleKey processes a key event from the event loop. It returns whether the
+// screen needs to be redrawn.
+func (a *Application) handleKey(event *tcell.EventKey) (draw bool) {
+	a.RLock()
+	root := a.root
+	inputCapture := a.inputCapture
+	a.RUnlock()
+
+	// Intercept keys.
+	if inputCapture != nil {
+		event = inputCapture(event)
+		if event == nil {
+			return true // Don't forward event.
+		}
+		draw = true
+	}
+
+	// Ctrl-C closes the application.
+	if event.Key() == tcell.KeyCtrlC {
+		a.Stop()
+		return false
+	}
+
+	event = a.translateFocusKey(event)
+
+	// Pass other key events to the root primitive.
+	if root != nil && root.HasFocus() {
+		if handler := root.InputHandler(); handler != nil {
+			handler(event, func(p Primitive) {
+				a.SetFocus(p)
+			})
+			draw = true
+		}
+	}
+
+	return
+}
+
+// SetFocusNextKey sets the key which moves the focus to the next primitive,
+// e.g. tcell.KeyCtrlN. The default is tcell.KeyTab. See SetFocusNextRune()
+// for character keys.
+//
+// Focus traversal is implemented by the primitives themselves (e.g. Form, or
+// Flex and Grid with a focus order function), which react to Tab and
+// Shift-Tab. The application translates the configured keys into these key
+// events before passing them on, unless the focused primitive consumes the key
+// itself (see KeyConsumer), in which case it receives the key unchanged. Tab
+// and Shift-Tab keep working as before.
+func (a *Application) SetFocusNextKey(key tcell.Key) *Application {
+	a.Lock()
+	defer a.Unlock()
+	a.focusNextKey = key
+	return a
+}
+
+// SetFocusPrevKey sets the key which moves the focus to the previous
+// primitive, e.g. tcell.KeyCtrlP. The default is tcell.KeyBacktab. See
+// SetFocusNextKey() for details and SetFocusPrevRune() for character keys.
+func (a *Application) SetFocusPrevKey(key tcell.Key) *Application {
+	a.Lock()
+	defer a.Unlock()
+	a.focusPrevKey = key
+	return a
+}
+
+// SetFocusNextRune sets a character key which moves the focus to the next
+// primitive, in addition to the key set with SetFocusNextKey(). Provide 0 to
+// remove it. See SetFocusNextKey() for details.
+func (a *Application) SetFocusNextRune(r rune) *Application {
+	a.Lock()
+	defer a.Unlock()
+	a.focusNextRune = r
+	return a
+}
+
+// SetFocusPrevRune sets a character key which moves the focus to the previous
+// primitive, in addition to the key set with SetFocusPrevKey(). Provide 0 to
+// remove it. See SetFocusNextKey() for details.
+func (a *Application) SetFocusPrevRune(r rune) *Application {
+	a.Lock()
+	defer a.Unlock()
+	a.focusPrevRune = r
+	return a
+}
+
+// KeyConsumer is implemented by primitives which handle keys that would
+// otherwise move the focus (see Application.SetFocusNextKey()). Box implements
+// it for keys bound with SetOnKey() and SetOnRune().
+type KeyConsumer interface {
+	ConsumesKey(event *tcell.EventKey) bool
+}
+
+// translateFocusKey returns a Tab or Backtab key event if the given event is
+// one of the configured focus traversal keys and the focused primitive
+// doesn't consume it. Otherwise, the event is returned unchanged.
+func (a *Application) translateFocusKey(event *tcell.EventKey) *tcell.EventKey {
+	a.RLock()
+	focus := a.focus
+	key, r := event.Key(), event.Rune()
+	next := key == a.focusNextKey && key != tcell.KeyRune || key == tcell.KeyRune && r != 0 && r == a.focusNextRune
+	prev := key == a.focusPrevKey && key != tcell.KeyRune || key == tcell.KeyRune && r != 0 && r == a.focusPrevRune
+	a.RUnlock()
+	if !next && !prev || key == tcell.KeyTab || key == tcell.KeyBacktab {
+		return event
+	}
+	if consumer, ok := focus.(KeyConsumer); ok && consumer.ConsumesKey(event) {
+		return event
+	}
+	if next {
+		return tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)
+	}
+	return tcell.NewEventKey(tcell.KeyBacktab, 0, tcell.ModShift)
+}
+
+// SetComposeMap installs a map of dead-key sequences to the characters they
+// produce, e.g. "'e" to 'é' or "~n" to 'ñ'. When the user types the start of a
+// sequence, the keys are held back until the sequence is complete, and then a
+// single key event with the composed character is delivered. If a key doesn't
+// continue the sequence, the held back characters are delivered as typed,
+// followed by that key.
+//
+// Provide nil to disable composition.
+func (a *Application) SetComposeMap(compose map[string]rune) *Application {
+	a.Lock()
+	defer a.Unlock()
+	a.composeMap = compose
+	a.composeBuffer = nil
+	return a
+}
+
+// compose applies the compose map to the given key event and returns the key
+// events which are to be processed, possibly none.
+func (a *Application) compose(event *tcell.EventKey) (events []*tcell.EventKey) {
+	a.Lock()
+	defer a.Unlock()
+	if len(a.composeMap) == 0 {
+		return []*tcell.EventKey{event}
+	}
+
+	// Flush held back characters.
+	flush := func() {
+		for _, r := range a.composeBuffer {
+			events = append(events, tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+		}
+		a.composeBuffer = nil
+	}
+
+	if event.Key() != tcell.KeyRune || event.Modifiers()&(tcell.ModCtrl|tcell.ModAlt|tcell.ModMeta) != 0 {
+		flush()
+		return append(events, event)
+	}
+
+	// Try the sequence including this key, then this key on its own.
+	for attempt := 0; attempt < 2; attempt++ {
+		sequence := string(append(a.composeBuffer, event.Rune()))
+		if r, ok := a.composeMap[sequence]; ok {
+			a.composeBuffer = nil
+			return append(events, tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone))
+		}
+		for key := range a.composeMap {
+			if len(key) > len(sequence) && strings.HasPrefix(key, sequence) {
+				a.composeBuffer = append(a.composeBuffer, event.Rune())
+				return
+			}
+		}
+		if len(a.composeBuffer) == 0 {
+			break
+		}
+		flush()
+	}
+	return append(events, event)
+}
+
+// SetTickInterval makes the application redraw the screen periodically at the
+// given interval while it is running, for primitives which change over time
+// without any events, such as boxes with a blinking border (see
+// Box.SetBorderBlinkInterval()). A duration of 0 (the default) stops the
+// periodic redraws.
+func (a *Application) SetTickInterval(interval time.Duration) *Application {
+	a.Lock()
+	defer a.Unlock()
+	if a.tickStop != nil {
+		close(a.tickStop)
+		a.tickStop = nil
+	}
+	if interval <= 0 {
+		return a
+	}
+	stop := make(chan struct{})
+	a.tickStop = stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-a.runContext.Done():
+				return
+			case <-ticker.C:
+				a.RLock()
+				running := a.screen != nil
+				a.RUnlock()
+				if running {
+					a.QueueUpdateDraw(func() {})
+				}
+			}
+		}
+	}()
+	return a
+}
+
+// SetResizeDebounce delays the notifications of SetResizeFunc() and Resizable
+// primitives until the terminal size has not changed for the given duration.
+// While the terminal is being resized, e.g. by dragging a window border, the
+// screen is still redrawn for every resize event, but primitives which
+// recompute expensive layouts in ScreenResized() only do so once. A duration
+// of 0 (the default) notifies immediately.
+func (a *Application) SetResizeDebounce(delay time.Duration) *Application {
+	a.Lock()
+	defer a.Unlock()
+	a.resizeDebounce = delay
+	return a
+}
+
+// notifyScreenResized invokes the resize callback and notifies the Resizable
+// primitives of the root's hierarchy of the given screen's current size.
+func (a *Application) notifyScreenResized(screen tcell.Screen) {
+	a.RLock()
+	resizeFunc, root := a.resize, a.root
+	a.RUnlock()
+	width, height := screen.Size()
+	if resizeFunc != nil {
+		resizeFunc(width, height)
+	}
+	if root != nil {
+		notifyResize(root, width, height)
+	}
+}
+
+// notifyResize calls ScreenResized() on the given primitive if it implements
+// Resizable and then descends into the children of this package's container
+// primitives.
+func notifyResize(p Primitive, width, height int) {
+	if p == nil {
+		return
+	}
+	if resizable, ok := p.(Resizable); ok {
+		resizable.ScreenResized(width, height)
+	}
+	for _, child := range containerChildren(p, false) {
+		notifyResize(child, width, height)
+	}
+}
+
+// containerChildren returns the contained primitives if p is a
+// primitiveContainer, in drawing order. If visibleOnly is true, primitives
+// which are currently not shown are omitted.
+func containerChildren(p Primitive, visibleOnly bool) []Primitive {
+	if container, ok := p.(primitiveContainer); ok {
+		return container.containedPrimitives(visibleOnly)
+	}
+	return nil
+}
+
+// GetDragPayload returns the payload of the current drag-and-drop operation
+// or nil if there is none. See DragSource for details.
+func (a *Application) GetDragPayload() any {
+	a.RLock()
+	defer a.RUnlock()
+	if a.drag == nil || !a.drag.moved {
+		return nil
+	}
+	return a.drag.payload
+}
+
+// handleDrag tracks drag-and-drop operations for the given mouse event. It
+// returns true if the event completed a drag, in which case the screen needs
+// to be redrawn. The event must still be processed by fireMouseActions()
+// afterwards.
+func (a *Application) handleDrag(event *tcell.EventMouse) bool {
+	a.RLock()
+	root, drag := a.root, a.drag
+	a.RUnlock()
+
+	x, y := event.Position()
+	pressed := event.Buttons()&tcell.ButtonPrimary != 0
+	wasPressed := a.lastMouseButtons&tcell.ButtonPrimary != 0
+
+	switch {
+	case pressed && !wasPressed:
+		// Start a drag if there is a drag source.
+		found := primitiveAt(root, x, y, func(p Primitive) bool {
+			_, ok := p.(DragSource)
+			return ok
+		})
+		if found == nil {
+			return false
+		}
+		source := found.(DragSource)
+		if payload, ok := source.DragStart(x, y); ok {
+			drag = &dragState{source: source, payload: payload}
+		}
+	case pressed && drag != nil:
+		if x != a.mouseDownX || y != a.mouseDownY {
+			drag.moved = true
+		}
+	case !pressed && wasPressed && drag != nil:
+		// Drop the payload.
+		a.Lock()
+		a.drag = nil
+		a.Unlock()
+		if !drag.moved {
+			return false
+		}
+		var accepted bool
+		if found := primitiveAt(root, x, y, func(p Primitive) bool {
+			_, ok := p.(DropTarget)
+			return ok
+		}); found != nil {
+			accepted = found.(DropTarget).Drop(drag.source, drag.payload, x, y)
+		}
+		drag.source.DragEnd(drag.payload, accepted)
+		return true
+	}
+
+	a.Lock()
+	a.drag = drag
+	a.Unlock()
+	return false
+}
 // SetAfterDrawFunc installs a callback function which is invoked after the root
 // primitive was drawn during screen updates.
 //
@@ -895,9 +1272,18 @@ func (a *Application) ResizeToFullScreen(p Primitive) *Application {
 // events.
 //
 // Blur() will be called on the previously focused primitive. Focus() will be
-// called on the new primitive.
+// called on the new primitive. If the previously focused primitive implements
+// BlurValidator (see Box.SetBlurValidator()) and refuses to lose focus, the
+// focus remains unchanged.
 func (a *Application) SetFocus(p Primitive) *Application {
 	a.Lock()
+	if focus, ok := a.focus.(BlurValidator); ok && a.focus != p && a.focus.HasFocus() {
+		a.Unlock()
+		if !focus.CanBlur() {
+			return a
+		}
+		a.Lock()
+	}
 	if a.beforeFocus != nil {
 		a.Unlock()
 		ok := a.beforeFocus(p)
@@ -0,0 +1,191 @@
+package tview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// Severity levels of a Banner.
+const (
+	BannerInfo = iota
+	BannerSuccess
+	BannerWarning
+	BannerError
+)
+
+// bannerLevel describes how a Banner of one severity level is drawn.
+type bannerLevel struct {
+	icon  string
+	style tcell.Style
+}
+
+// Banner is a persistent, one-line notice such as "You are offline" or "An
+// update is available", typically placed at the top of a Flex above the main
+// content. It shows an icon and a message, colored according to its severity,
+// and optionally a dismiss button on the right.
+//
+// A dismissable banner is dismissed by clicking the button or by pressing
+// Enter, Escape, or "x" while it has focus. It then hides itself and calls the
+// dismiss handler, which may also remove it from its container.
+type Banner struct {
+	*Box
+
+	// The severity level, one of the Banner constants.
+	level int
+
+	// The message, which may contain color tags.
+	message string
+
+	// The icons and styles of the severity levels.
+	levels map[int]bannerLevel
+
+	// Whether a dismiss button is shown.
+	dismissable bool
+
+	// The label of the dismiss button.
+	dismissLabel string
+
+	// An optional function which is called when the banner was dismissed.
+	dismissed func()
+}
+
+// NewBanner returns a new, empty informational banner.
+func NewBanner() *Banner {
+	return &Banner{
+		Box:          NewBox(),
+		dismissLabel: "[×]",
+		levels: map[int]bannerLevel{
+			BannerInfo:    {"ℹ", tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorNavy)},
+			BannerSuccess: {"✔", tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorDarkGreen)},
+			BannerWarning: {"⚠", tcell.StyleDefault.Foreground(tcell.ColorBlack).Background(tcell.ColorGold)},
+			BannerError:   {"✖", tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorDarkRed)},
+		},
+	}
+}
+
+// SetMessage sets the severity level (BannerInfo, BannerSuccess,
+// BannerWarning, or BannerError) and the message, which may contain color
+// tags. A banner which was dismissed is shown again.
+func (b *Banner) SetMessage(level int, text string) *Banner {
+	b.level, b.message = level, text
+	b.SetVisible(true)
+	return b
+}
+
+// GetMessage returns the severity level and the message.
+func (b *Banner) GetMessage() (level int, text string) {
+	return b.level, b.message
+}
+
+// SetLevelStyle sets the icon and the style used for the given severity level.
+func (b *Banner) SetLevelStyle(level int, icon string, style tcell.Style) *Banner {
+	b.levels[level] = bannerLevel{icon: icon, style: style}
+	return b
+}
+
+// SetDismissable sets whether a dismiss button is shown.
+func (b *Banner) SetDismissable(dismissable bool) *Banner {
+	b.dismissable = dismissable
+	return b
+}
+
+// SetDismissLabel sets the label of the dismiss button. The default is "[×]".
+func (b *Banner) SetDismissLabel(label string) *Banner {
+	b.dismissLabel = label
+	return b
+}
+
+// SetDismissedFunc sets a handler which is called when the user dismisses the
+// banner.
+func (b *Banner) SetDismissedFunc(handler func()) *Banner {
+	b.dismissed = handler
+	return b
+}
+
+// Dismiss hides the banner and calls the dismiss handler.
+func (b *Banner) Dismiss() {
+	b.SetVisible(false)
+	if b.dismissed != nil {
+		b.dismissed()
+	}
+}
+
+// style returns the style of the current severity level.
+func (b *Banner) style() (icon string, style tcell.Style) {
+	level, ok := b.levels[b.level]
+	if !ok {
+		level = b.levels[BannerInfo]
+	}
+	return level.icon, level.style
+}
+
+// buttonX returns the screen column at which the dismiss button starts.
+func (b *Banner) buttonX() int {
+	x, _, width, _ := b.GetInnerRect()
+	return x + width - TaggedStringWidth(b.dismissLabel) - 1
+}
+
+// Draw draws this primitive onto the screen.
+func (b *Banner) Draw(screen tcell.Screen) {
+	if !b.Box.DrawForSubclass(screen, b) {
+		return
+	}
+	x, y, width, height := b.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+	icon, style := b.style()
+	for row := y; row < y+height; row++ {
+		for column := x; column < x+width; column++ {
+			screen.SetContent(column, row, ' ', nil, style)
+		}
+	}
+	y += (height - 1) / 2
+
+	right := x + width
+	if b.dismissable {
+		right = b.buttonX() - 1
+		printWithStyle(screen, b.dismissLabel, right+1, y, 0, x+width-right-1, AlignLeft, style.Bold(true), true)
+	}
+	textX := x + 1
+	if icon != "" {
+		_, printed, _, _ := printWithStyle(screen, Escape(icon), textX, y, 0, right-textX, AlignLeft, style.Bold(true), true)
+		textX += printed + 1
+	}
+	if textX < right {
+		printWithStyle(screen, b.message, textX, y, 0, right-textX, AlignLeft, style, true)
+	}
+}
+
+// InputHandler returns the handler for this primitive.
+func (b *Banner) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
+	return b.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		if !b.dismissable {
+			return
+		}
+		switch event.Key() {
+		case tcell.KeyEnter, tcell.KeyEscape:
+			b.Dismiss()
+		case tcell.KeyRune:
+			if event.Rune() == 'x' {
+				b.Dismiss()
+			}
+		}
+	})
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (b *Banner) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+	return b.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		x, y := event.Position()
+		if !b.InRect(x, y) {
+			return false, nil
+		}
+		if action == MouseLeftClick {
+			if b.dismissable && x >= b.buttonX() {
+				b.Dismiss()
+			}
+			consumed = true
+		}
+		return
+	})
+}
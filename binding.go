@@ -0,0 +1,117 @@
+package tview
+
+import "sync"
+
+// Observable holds a value and notifies subscribers when it changes. It is
+// safe for concurrent use and is the model side of data bindings, see Bind().
+type Observable[T comparable] struct {
+	sync.Mutex
+
+	// The current value.
+	value T
+
+	// The functions to call when the value changes, by subscription ID.
+	observers map[int]func(value T)
+
+	// The ID of the next subscription.
+	nextID int
+}
+
+// NewObservable returns a new observable holding the given initial value.
+func NewObservable[T comparable](value T) *Observable[T] {
+	return &Observable[T]{
+		value:     value,
+		observers: make(map[int]func(value T)),
+	}
+}
+
+// Get returns the current value.
+func (o *Observable[T]) Get() T {
+	o.Lock()
+	defer o.Unlock()
+	return o.value
+}
+
+// Set changes the value and notifies all subscribers. Nothing happens if the
+// value is equal to the current value. Subscribers are called on the calling
+// goroutine.
+func (o *Observable[T]) Set(value T) {
+	o.Lock()
+	if value == o.value {
+		o.Unlock()
+		return
+	}
+	o.value = value
+	observers := make([]func(value T), 0, len(o.observers))
+	for _, observer := range o.observers {
+		observers = append(observers, observer)
+	}
+	o.Unlock()
+
+	for _, observer := range observers {
+		observer(value)
+	}
+}
+
+// Subscribe registers a function which is called with the new value whenever
+// it changes. The returned function cancels the subscription.
+func (o *Observable[T]) Subscribe(observer func(value T)) (unsubscribe func()) {
+	o.Lock()
+	defer o.Unlock()
+	id := o.nextID
+	o.nextID++
+	o.observers[id] = observer
+	return func() {
+		o.Lock()
+		defer o.Unlock()
+		delete(o.observers, id)
+	}
+}
+
+// Bind connects an observable value to a widget. The update function, which
+// typically sets the widget's content, is called with the current value right
+// away and then whenever the value changes. Because values may be changed from
+// any goroutine, these calls are marshaled onto the application's event loop
+// with QueueUpdateDraw(). Widget edits are propagated back by calling the
+// observable's Set() function from the widget's "changed" handler.
+//
+// The returned function removes the binding.
+func Bind[T comparable](app *Application, value *Observable[T], update func(value T)) (unbind func()) {
+	current := value.Get()
+	app.QueueUpdateDraw(func() {
+		update(current)
+	})
+	return value.Subscribe(func(v T) {
+		app.QueueUpdateDraw(func() {
+			update(v)
+		})
+	})
+}
+
+// BindInputField keeps the text of an input field and an observable string in
+// sync. Note that this replaces the input field's "changed" handler.
+func BindInputField(app *Application, field *InputField, value *Observable[string]) (unbind func()) {
+	field.SetChangedFunc(value.Set)
+	return Bind(app, value, func(text string) {
+		if field.GetText() != text {
+			field.SetText(text)
+		}
+	})
+}
+
+// BindCheckbox keeps the state of a checkbox and an observable boolean in sync.
+// Note that this replaces the checkbox's "changed" handler.
+func BindCheckbox(app *Application, checkbox *Checkbox, value *Observable[bool]) (unbind func()) {
+	checkbox.SetChangedFunc(value.Set)
+	return Bind(app, value, func(checked bool) {
+		checkbox.SetChecked(checked)
+	})
+}
+
+// BindTextView displays an observable string in a text view. Text views are
+// not editable, so the binding only goes in one direction.
+func BindTextView(app *Application, textView *TextView, value *Observable[string]) (unbind func()) {
+	return Bind(app, value, func(text string) {
+		textView.SetText(text)
+	})
+}
@@ -0,0 +1,122 @@
+package tview
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// runQueuedUpdates executes the functions queued with QueueUpdate() as the
+// application's event loop would.
+func runQueuedUpdates(app *Application) {
+	for {
+		select {
+		case update := <-app.updates:
+			update.f()
+		default:
+			return
+		}
+	}
+}
+
+// TestObservable checks that subscribers are notified of changed values only,
+// until they unsubscribe.
+func TestObservable(t *testing.T) {
+	value := NewObservable(1)
+	var received []int
+	unsubscribe := value.Subscribe(func(v int) {
+		received = append(received, v)
+	})
+
+	value.Set(2)
+	value.Set(2) // Unchanged.
+	value.Set(3)
+	unsubscribe()
+	value.Set(4)
+
+	if expected := []int{2, 3}; !reflect.DeepEqual(received, expected) {
+		t.Errorf("received %v, expected %v", received, expected)
+	}
+	if v := value.Get(); v != 4 {
+		t.Errorf("value is %d, expected 4", v)
+	}
+}
+
+// TestBind checks that bound update functions run in the application's event
+// loop, starting with the current value.
+func TestBind(t *testing.T) {
+	app := NewApplication()
+	value := NewObservable("a")
+	var updates []string
+	unbind := Bind(app, value, func(v string) {
+		updates = append(updates, v)
+	})
+	if len(updates) != 0 {
+		t.Fatalf("update function called outside the event loop with %v", updates)
+	}
+	runQueuedUpdates(app)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		value.Set("b")
+	}()
+	wg.Wait()
+	runQueuedUpdates(app)
+
+	unbind()
+	value.Set("c")
+	runQueuedUpdates(app)
+
+	if expected := []string{"a", "b"}; !reflect.DeepEqual(updates, expected) {
+		t.Errorf("updates are %v, expected %v", updates, expected)
+	}
+}
+
+// TestBindWidgets checks that bound widgets and observables follow each
+// other.
+func TestBindWidgets(t *testing.T) {
+	app := NewApplication()
+
+	text := NewObservable("initial")
+	field := NewInputField()
+	BindInputField(app, field, text)
+	runQueuedUpdates(app)
+	if field.GetText() != "initial" {
+		t.Errorf("input field text is %q, expected %q", field.GetText(), "initial")
+	}
+	field.SetText("typed")
+	if text.Get() != "typed" {
+		t.Errorf("observable is %q after editing the input field, expected %q", text.Get(), "typed")
+	}
+	text.Set("set")
+	runQueuedUpdates(app)
+	if field.GetText() != "set" {
+		t.Errorf("input field text is %q, expected %q", field.GetText(), "set")
+	}
+
+	checked := NewObservable(true)
+	checkbox := NewCheckbox()
+	BindCheckbox(app, checkbox, checked)
+	runQueuedUpdates(app)
+	if !checkbox.IsChecked() {
+		t.Error("checkbox is not checked, expected it to be checked")
+	}
+	checkbox.InputHandler()(tcell.NewEventKey(tcell.KeyRune, ' ', tcell.ModNone), func(p Primitive) {})
+	if checked.Get() {
+		t.Error("observable is true after unchecking the checkbox, expected false")
+	}
+
+	content := NewObservable("first")
+	textView := NewTextView()
+	BindTextView(app, textView, content)
+	content.Set("second")
+	runQueuedUpdates(app)
+	if text := strings.TrimSuffix(textView.GetText(false), "\n"); text != "second" {
+		t.Errorf("text view text is %q, expected %q", text, "second")
+	}
+}
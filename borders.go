@@ -51,6 +51,122 @@ var DefaultBorders = &BorderStyle{
 }
 var Borders = *DefaultBorders
 
+// BordersRounded is a border style with light lines and rounded corners. Focused borders
+// are drawn with double lines.
+var BordersRounded = BorderStyle{
+	Horizontal:  BoxDrawingsLightHorizontal,
+	Vertical:    BoxDrawingsLightVertical,
+	TopLeft:     BoxDrawingsLightArcDownAndRight,
+	TopRight:    BoxDrawingsLightArcDownAndLeft,
+	BottomLeft:  BoxDrawingsLightArcUpAndRight,
+	BottomRight: BoxDrawingsLightArcUpAndLeft,
+
+	TopHorizontal:    BoxDrawingsLightHorizontal,
+	BottomHorizontal: BoxDrawingsLightHorizontal,
+	LeftVertical:     BoxDrawingsLightVertical,
+	RightVertical:    BoxDrawingsLightVertical,
+
+	LeftT:   BoxDrawingsLightVerticalAndRight,
+	RightT:  BoxDrawingsLightVerticalAndLeft,
+	TopT:    BoxDrawingsLightDownAndHorizontal,
+	BottomT: BoxDrawingsLightUpAndHorizontal,
+	Cross:   BoxDrawingsLightVerticalAndHorizontal,
+
+	HorizontalFocus:  BoxDrawingsDoubleHorizontal,
+	VerticalFocus:    BoxDrawingsDoubleVertical,
+	TopLeftFocus:     BoxDrawingsDoubleDownAndRight,
+	TopRightFocus:    BoxDrawingsDoubleDownAndLeft,
+	BottomLeftFocus:  BoxDrawingsDoubleUpAndRight,
+	BottomRightFocus: BoxDrawingsDoubleUpAndLeft,
+}
+
+// BordersDouble is a border style with double lines. Focused borders are drawn with
+// heavy lines.
+var BordersDouble = BorderStyle{
+	Horizontal:  BoxDrawingsDoubleHorizontal,
+	Vertical:    BoxDrawingsDoubleVertical,
+	TopLeft:     BoxDrawingsDoubleDownAndRight,
+	TopRight:    BoxDrawingsDoubleDownAndLeft,
+	BottomLeft:  BoxDrawingsDoubleUpAndRight,
+	BottomRight: BoxDrawingsDoubleUpAndLeft,
+
+	TopHorizontal:    BoxDrawingsDoubleHorizontal,
+	BottomHorizontal: BoxDrawingsDoubleHorizontal,
+	LeftVertical:     BoxDrawingsDoubleVertical,
+	RightVertical:    BoxDrawingsDoubleVertical,
+
+	LeftT:   BoxDrawingsDoubleVerticalAndRight,
+	RightT:  BoxDrawingsDoubleVerticalAndLeft,
+	TopT:    BoxDrawingsDoubleDownAndHorizontal,
+	BottomT: BoxDrawingsDoubleUpAndHorizontal,
+	Cross:   BoxDrawingsDoubleVerticalAndHorizontal,
+
+	HorizontalFocus:  BoxDrawingsHeavyHorizontal,
+	VerticalFocus:    BoxDrawingsHeavyVertical,
+	TopLeftFocus:     BoxDrawingsHeavyDownAndRight,
+	TopRightFocus:    BoxDrawingsHeavyDownAndLeft,
+	BottomLeftFocus:  BoxDrawingsHeavyUpAndRight,
+	BottomRightFocus: BoxDrawingsHeavyUpAndLeft,
+}
+
+// BordersThick is a border style with heavy lines. Focused borders are drawn with
+// double lines.
+var BordersThick = BorderStyle{
+	Horizontal:  BoxDrawingsHeavyHorizontal,
+	Vertical:    BoxDrawingsHeavyVertical,
+	TopLeft:     BoxDrawingsHeavyDownAndRight,
+	TopRight:    BoxDrawingsHeavyDownAndLeft,
+	BottomLeft:  BoxDrawingsHeavyUpAndRight,
+	BottomRight: BoxDrawingsHeavyUpAndLeft,
+
+	TopHorizontal:    BoxDrawingsHeavyHorizontal,
+	BottomHorizontal: BoxDrawingsHeavyHorizontal,
+	LeftVertical:     BoxDrawingsHeavyVertical,
+	RightVertical:    BoxDrawingsHeavyVertical,
+
+	LeftT:   BoxDrawingsHeavyVerticalAndRight,
+	RightT:  BoxDrawingsHeavyVerticalAndLeft,
+	TopT:    BoxDrawingsHeavyDownAndHorizontal,
+	BottomT: BoxDrawingsHeavyUpAndHorizontal,
+	Cross:   BoxDrawingsHeavyVerticalAndHorizontal,
+
+	HorizontalFocus:  BoxDrawingsDoubleHorizontal,
+	VerticalFocus:    BoxDrawingsDoubleVertical,
+	TopLeftFocus:     BoxDrawingsDoubleDownAndRight,
+	TopRightFocus:    BoxDrawingsDoubleDownAndLeft,
+	BottomLeftFocus:  BoxDrawingsDoubleUpAndRight,
+	BottomRightFocus: BoxDrawingsDoubleUpAndLeft,
+}
+
+// BordersDashed is a border style with light dashed lines and light corners. Focused
+// borders are drawn with heavy dashed lines and heavy corners.
+var BordersDashed = BorderStyle{
+	Horizontal:  BoxDrawingsLightDoubleDashHorizontal,
+	Vertical:    BoxDrawingsLightDoubleDashVertical,
+	TopLeft:     BoxDrawingsLightDownAndRight,
+	TopRight:    BoxDrawingsLightDownAndLeft,
+	BottomLeft:  BoxDrawingsLightUpAndRight,
+	BottomRight: BoxDrawingsLightUpAndLeft,
+
+	TopHorizontal:    BoxDrawingsLightDoubleDashHorizontal,
+	BottomHorizontal: BoxDrawingsLightDoubleDashHorizontal,
+	LeftVertical:     BoxDrawingsLightDoubleDashVertical,
+	RightVertical:    BoxDrawingsLightDoubleDashVertical,
+
+	LeftT:   BoxDrawingsLightVerticalAndRight,
+	RightT:  BoxDrawingsLightVerticalAndLeft,
+	TopT:    BoxDrawingsLightDownAndHorizontal,
+	BottomT: BoxDrawingsLightUpAndHorizontal,
+	Cross:   BoxDrawingsLightVerticalAndHorizontal,
+
+	HorizontalFocus:  BoxDrawingsHeavyDoubleDashHorizontal,
+	VerticalFocus:    BoxDrawingsHeavyDoubleDashVertical,
+	TopLeftFocus:     BoxDrawingsHeavyDownAndRight,
+	TopRightFocus:    BoxDrawingsHeavyDownAndLeft,
+	BottomLeftFocus:  BoxDrawingsHeavyUpAndRight,
+	BottomRightFocus: BoxDrawingsHeavyUpAndLeft,
+}
+
 func ResetBorderStyle() {
   Borders = *DefaultBorders
 }
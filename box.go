@@ -2,10 +2,64 @@ package tview
 
 import (
 	"math"
+	"sync"
+	"time"
 
 	tcell "github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
 )
 
+// Precedence when the title and the badge don't both fit into the top border,
+// see Box.SetTitleBadgePriority().
+const (
+	TitleBadgePriorityTitle = iota // Truncate the badge first.
+	TitleBadgePriorityBadge        // Truncate the title first.
+)
+
+// Visibility modes of the overflow indicator, see Box.SetOverflowVisibility().
+const (
+	OverflowAlways = iota // Draw the indicator whenever it is enabled.
+	OverflowAuto          // Draw the indicator only if the content overflows.
+	OverflowNever         // Never draw the indicator.
+)
+
+// Border corners, see Box.SetBorderCorner().
+const (
+	BorderCornerTopLeft = iota
+	BorderCornerTopRight
+	BorderCornerBottomLeft
+	BorderCornerBottomRight
+)
+
+// Sides of the overflow indicator, see Box.SetOverflowSide().
+const (
+	OverflowSideRight = iota // Draw the indicator to the right of the inner rect.
+	OverflowSideLeft         // Draw the indicator to the left of the inner rect.
+)
+
+// keyBinding is a simple key handler, see Box.SetOnKey(), Box.SetOnRune(),
+// Box.BindKey(), and Box.BindRune().
+type keyBinding struct {
+	key tcell.Key
+	r   rune // Only used if key is tcell.KeyRune.
+
+	// The modifiers which must be pressed, if modSet is true. Otherwise, the
+	// modifiers are ignored.
+	mod    tcell.ModMask
+	modSet bool
+
+	// The handler, returning whether the event was consumed.
+	handler func() bool
+}
+
+// matches returns whether the given event triggers this binding.
+func (k *keyBinding) matches(event *tcell.EventKey) bool {
+	if event.Key() != k.key || k.modSet && event.Modifiers() != k.mod {
+		return false
+	}
+	return k.key != tcell.KeyRune || event.Rune() == k.r
+}
+
 // Box implements Primitive with a background and optional elements such as a
 // border and a title. Most subclasses keep their content contained in the box
 // but don't necessarily have to.
@@ -21,6 +75,31 @@ type Box struct {
 	// The inner rect reserved for the box's content.
 	innerX, innerY, innerWidth, innerHeight int
 
+	// The inner rect of the last draw and whether a subclass's cached layout
+	// is valid for it, see IsLayoutValid().
+	layoutRect  [4]int
+	layoutValid bool
+
+	// Whether the inner rect was reduced to fit the screen during the last
+	// draw.
+	clipped bool
+
+	// The minimum size enforced by SetRect().
+	minWidth, minHeight int
+
+	// An optional function returning the desired size within the available
+	// space, see SetMeasureFunc().
+	measure func(maxWidth, maxHeight int) (width, height int)
+
+	// If not nil, the position and size in percent of the screen size, see
+	// SetRectPercent().
+	rectPercent *[4]float64
+
+	// Whether the rect is managed by a layout, which makes SetRect() calls
+	// from anyone else ineffective, and whether the layout is currently
+	// setting the rect.
+	managed, layoutPass bool
+
 	// Border padding.
 	paddingTop, paddingBottom, paddingLeft, paddingRight int
 
@@ -30,6 +109,22 @@ type Box struct {
 	// The box's background color.
 	backgroundColor tcell.Color
 
+	// If not empty, these runes are tiled across the background instead of
+	// filling it with spaces, using the pattern style.
+	backgroundPattern []rune
+	patternStyle      tcell.Style
+
+	// If gradient is true, the background is filled with a gradient from the
+	// first to the second color, top to bottom if gradientVertical is true and
+	// left to right otherwise.
+	gradient         bool
+	gradientColors   [2]tcell.Color
+	gradientVertical bool
+
+	// If true and the background color is tcell.ColorDefault, the background
+	// is not filled so that the terminal's own background shows through.
+	useTerminalBackground bool
+
 	// Reverse video.
 	reverse bool
 
@@ -43,23 +138,116 @@ type Box struct {
 	// The color of the border when the box has focus.
 	borderFocusColor tcell.Color
 
+	// Whether the border is drawn as focused while a descendant has focus,
+	// and whether one had focus when the box was last drawn.
+	borderFocusFollowsChildren bool
+	descendantFocused          bool
+
+	// The theme of this box, overriding the global Styles, see SetTheme().
+	// nil if the global Styles apply.
+	theme *Theme
+
+	// The colors which are taken from the theme whenever the box is drawn.
+	// Colors set explicitly are removed.
+	themeColors themeColor
+
+	// If positive, the duration over which the border color fades between
+	// the border color and the focus color, see SetBorderFocusFade(). The
+	// current position of the fade, from 0 (border color) to 1 (focus
+	// color), and the running fade animation, nil if there is none.
+	borderFocusFade     time.Duration
+	borderFade          float64
+	borderFadeAnimation *Animation
+
+	// The colors of the top, left, bottom, and right border sides when the box
+	// doesn't have focus. tcell.ColorDefault uses the border color.
+	borderSideColors [4]tcell.Color
+
 	borderBlinking bool
 	borderStyles   *BorderStyle
 
+	// If positive, the border is hidden and shown alternately, switching
+	// after this interval, see SetBorderBlinkInterval().
+	borderBlinkInterval time.Duration
+	borderBlinkToggled  time.Time
+	borderBlinkOff      bool
+
+	// Glyphs overriding individual corners of the border style, keyed by the
+	// BorderCorner constants.
+	borderCorners map[int]rune
+
+	// The number of concentric border rings.
+	borderThickness int
+
+	// Whether a second frame is drawn inside the border, and its color.
+	// tcell.ColorDefault uses the border's colors.
+	doubleFrame     bool
+	innerFrameColor tcell.Color
+
+	// Whether a dim ring is drawn around the box while it has focus.
+	focusGlow bool
+
+	// Whether a drop shadow is drawn along the right and bottom edges outside
+	// the box, and its color.
+	shadow      bool
+	shadowColor tcell.Color
+
+	// Whether the box is drawn dimmed while it doesn't have focus, and
+	// whether it was dimmed during the current draw.
+	dimUnfocused, dimmed bool
+
 	// If set to true, the text view will show down and up arrows if there is
 	// content out of sight. While box doesn't implement scrolling, this is
 	// an abstraction for other components
 	indicateOverflow bool
 
+	// When the overflow indicator is drawn. One of the Overflow constants.
+	overflowVisibility int
+
+	// The side of the inner rect on which the overflow indicator is drawn.
+	// One of the OverflowSide constants.
+	overflowSide int
+
+	// An optional function which is called when the overflow indicator is
+	// clicked.
+	overflowScroll func(fraction float64)
+
 	// The title. Only visible if there is a border, too.
 	title string
 
+	// Whether color tags in the title are interpreted. If false, the title
+	// is printed literally.
+	titleDynamicColors bool
+
 	// The color of the title.
 	titleColor tcell.Color
 
 	// The alignment of the title.
 	titleAlign int
 
+	// Whether a title too long for the top border is wrapped onto additional
+	// rows.
+	titleWrap bool
+
+	// The number of cells kept free to the left and right of the title.
+	titlePaddingLeft, titlePaddingRight int
+
+	// The subtitle drawn into the bottom border, its color, and its alignment.
+	subtitle      string
+	subtitleColor tcell.Color
+	subtitleAlign int
+
+	// A short text drawn into the top-right corner of the border, e.g. a
+	// counter or a status. Only visible if there is a border, too.
+	badge string
+
+	// The color of the badge.
+	badgeColor tcell.Color
+
+	// Which of title and badge is truncated first if the top border is too
+	// narrow for both. One of the TitleBadgePriority constants.
+	titleBadgePriority int
+
 	// Provides a way to find out if this box has focus. We always go through
 	// this interface because it may be overridden by implementing classes.
 	focus Focusable
@@ -74,27 +262,122 @@ type Box struct {
 	// nothing should be forwarded).
 	inputCapture func(event *tcell.EventKey) *tcell.EventKey
 
+	// Whether or not the box is disabled, i.e. drawn dimmed, ignoring input,
+	// and skipped in focus traversal.
+	disabled bool
+
+	// Whether key events the box doesn't handle are passed on to the parent,
+	// and whether this is currently happening.
+	bubbleKeys, bubbling bool
+
+	// Simple key handlers registered with SetOnKey() and SetOnRune(), consulted
+	// after the input capture function.
+	keyBindings []*keyBinding
+
 	mouseHandler func(event *tcell.EventMouse) bool
 	mouseCapture func(action MouseAction, event *tcell.EventMouse) (MouseAction, *tcell.EventMouse)
 
 	// An optional function which is called before the box is drawn.
 	draw func(screen tcell.Screen, x, y, width, height int) (int, int, int, int)
-  evented  EventedFunc
+
+	// An optional function which is called before anything is drawn. If it
+	// returns true, the box is not drawn.
+	beforeDraw func(screen tcell.Screen) bool
+
+	// An optional function which receives the events sent by the box, see
+	// SetEventedFunc().
+	evented EventedFunc
+
+	// If not nil, events are delayed and collapsed, see SetEventDebounce().
+	eventDebounce *eventDebouncer
+
+	// An optional function which is called when the title is clicked.
+	onTitleClick func()
+
+	// Tabs drawn into the top border instead of the title, the index of the
+	// highlighted one, and an optional function called when one is clicked.
+	titleTabs      []string
+	titleTabActive int
+	titleTabClick  func(index int)
+
+	// An optional function which is called when the box is double-clicked.
+	onDoubleClick func(x, y int)
+
+	// An optional function which is called by the default mouse handler when
+	// the box is right-clicked.
+	rightClick func(x, y int) bool
+
+	// An optional function which is called by the default mouse handler when
+	// the mouse wheel is turned over the box.
+	scroll func(delta int) bool
+
+	// Optional functions which are called by the default mouse handler when
+	// the mouse pointer moves into or out of the box, and whether the pointer
+	// was inside the box at the last mouse movement.
+	mouseEnter, mouseLeave func()
+	hovered                bool
 
 	// Handler that gets called when this component receives focus.
 	onFocus func()
 
 	// Handler that gets called when this component loses focus.
 	onBlur      func()
+
+	// Handlers that get called when the size of this component changes,
+	// immediately and once resizing has settled, respectively.
+	onResize         func(width, height int)
+	onResizeComplete func(width, height int)
+
+	// How long the size must remain unchanged before onResizeComplete is
+	// called, and the timer counting down this delay.
+	resizeCompleteDelay time.Duration
+	resizeCompleteTimer *time.Timer
+
+	// An optional function which is consulted before this component loses
+	// focus. If it returns false, the component keeps focus.
+	blurValidator func() bool
+
+	// If not tcell.ColorDefault, the border blinks in this color after the
+	// blur validator has rejected a focus change, until it accepts one.
+	blurRejectedColor tcell.Color
+
+	// Whether the blur validator's last result was a rejection.
+	blurRejected bool
+
 	borderStyle tcell.Style
-	dontClear   bool
+
+	// Whether the background is left as is instead of being filled, see
+	// SetBackgroundTransparent().
+	dontClear bool
 
 	nextFocusableComponents map[FocusDirection][]Primitive
 	parent                  Primitive
 
+	// Whether NextFocusableComponent() falls back to the first registered
+	// component if none is visible.
+	focusWrap bool
+
 	onPaste      func([]rune)
 	focusManager *FocusManager
 	animating    bool
+
+	// The number of animations started with Animate() which are not done yet.
+	runningAnimations int
+
+	// The running slide transition, see SlideIn() and SlideOut(). nil if
+	// there is none.
+	slideState *slideState
+
+	// The border style animation started with AnimateBorderStyle(), nil if
+	// there is none.
+	borderAnimation *borderAnimation
+}
+
+// borderAnimation is a running border style animation.
+type borderAnimation struct {
+	from, to      *BorderStyle
+	frame, frames int
+	perFrame      func()
 }
 
 // NewBox returns a Box without a border.
@@ -110,6 +393,10 @@ func NewBox() *Box {
 		borderFocusColor:        Styles.BorderFocusColor,
 		titleColor:              Styles.TitleColor,
 		titleAlign:              AlignCenter,
+		titleDynamicColors:      true,
+		subtitleColor:           Styles.TitleColor,
+		subtitleAlign:           AlignCenter,
+		badgeColor:              Styles.TitleColor,
 		borderTop:               true,
 		borderBottom:            true,
 		borderLeft:              true,
@@ -117,6 +404,8 @@ func NewBox() *Box {
 		visible:                 true,
 		borderVisible:           true,
 		borderStyles:            &Borders,
+		borderThickness:         1,
+		shadowColor:             tcell.ColorBlack,
 		animating:               false,
 		nextFocusableComponents: make(map[FocusDirection][]Primitive),
 	}
@@ -125,25 +414,232 @@ func NewBox() *Box {
 	return b
 }
 
+// Clone returns a new box with the same configuration as this one: position,
+// colors, borders, padding, title, badge, styles, and installed handlers. The
+// border glyphs are copied rather than shared. Runtime state such as focus,
+// the parent, the focus manager, and animation state is not copied. The
+// clone's focus navigation targets (see SetNextFocusableComponents()) are a
+// copy of this box's.
+func (b *Box) Clone() *Box {
+	c := *b
+	c.focus = &c
+	c.hasFocus = false
+	c.parent = nil
+	c.managed = false
+	c.layoutPass = false
+	c.hovered = false
+	c.focusManager = nil
+	c.animating = false
+	c.runningAnimations = 0
+	c.slideState = nil
+	c.borderFade = 0
+	c.borderFadeAnimation = nil
+	c.eventDebounce = nil
+	c.bubbling = false
+	c.descendantFocused = false
+	c.borderAnimation = nil
+	c.clipped = false
+	c.blurRejected = false
+	c.innerX = -1
+	c.layoutValid = false
+	c.resizeCompleteTimer = nil
+	if b.borderStyles != nil {
+		borders := *b.borderStyles
+		c.borderStyles = &borders
+	}
+	c.backgroundPattern = append([]rune(nil), b.backgroundPattern...)
+	if b.borderCorners != nil {
+		c.borderCorners = make(map[int]rune, len(b.borderCorners))
+		for corner, r := range b.borderCorners {
+			c.borderCorners[corner] = r
+		}
+	}
+	c.keyBindings = append([]*keyBinding(nil), b.keyBindings...)
+	c.nextFocusableComponents = make(map[FocusDirection][]Primitive, len(b.nextFocusableComponents))
+	for direction, components := range b.nextFocusableComponents {
+		c.nextFocusableComponents[direction] = append([]Primitive(nil), components...)
+	}
+	return &c
+}
+
+// themeColor is a set of box colors which follow the box's theme.
+type themeColor int
+
+// The box colors which may follow the theme.
+const (
+	themeBackground themeColor = 1 << iota
+	themeBorder
+	themeBorderFocus
+	themeTitle
+	themeSubtitle
+	themeBadge
+
+	themeAll = themeBackground | themeBorder | themeBorderFocus | themeTitle | themeSubtitle | themeBadge
+)
+
+// SetTheme sets colors of this box from the given theme instead of the global
+// Styles, e.g. to give each pane of an application its own accent color
+// without changing global state. The background, border, focus border, title,
+// subtitle, and badge colors are taken from the theme, replacing colors set
+// before. The box keeps a reference to the theme and reads these colors again
+// whenever it is drawn, so changes to the theme show with the next redraw.
+// Colors set afterwards override the theme's. Drawing code which consults the
+// global Styles uses the theme instead (see GetTheme()). Provide nil to apply
+// the global Styles again.
+func (b *Box) SetTheme(theme *Theme) *Box {
+	b.theme = theme
+	b.themeColors = themeAll
+	b.applyTheme()
+	return b
+}
+
+// applyTheme sets the colors which follow the theme (see SetTheme()) from the
+// theme's current values.
+func (b *Box) applyTheme() {
+	if b.themeColors == 0 {
+		return
+	}
+	t := b.GetTheme()
+	if b.themeColors&themeBackground != 0 {
+		b.backgroundColor = t.PrimitiveBackgroundColor
+		b.borderStyle = b.borderStyle.Background(t.PrimitiveBackgroundColor)
+	}
+	if b.themeColors&themeBorder != 0 {
+		b.borderColor = t.BorderColor
+		b.borderStyle = b.borderStyle.Foreground(t.BorderColor)
+	}
+	if b.themeColors&themeBorderFocus != 0 {
+		b.borderFocusColor = t.BorderFocusColor
+	}
+	if b.themeColors&themeTitle != 0 {
+		b.titleColor = t.TitleColor
+	}
+	if b.themeColors&themeSubtitle != 0 {
+		b.subtitleColor = t.TitleColor
+	}
+	if b.themeColors&themeBadge != 0 {
+		b.badgeColor = t.TitleColor
+	}
+}
+
+// GetTheme returns the theme set with SetTheme() or, if there is none, the
+// global Styles. Subclasses may use it to pick colors at draw time.
+func (b *Box) GetTheme() *Theme {
+	if b.theme != nil {
+		return b.theme
+	}
+	return &Styles
+}
+
+// GetFocusManager returns the focus manager set with SetFocusManager(), or nil
+// if there is none.
 func (b *Box) GetFocusManager() *FocusManager {
 	return b.focusManager
 }
 
+// SetFocusManager associates the box with a focus manager, e.g. the one whose
+// tab ring the box is part of, so that handlers can move the focus with
+// GetFocusManager().Next() and GetFocusManager().Prev(). It does not add the
+// box to the manager, see FocusManager.Add().
 func (b *Box) SetFocusManager(fm *FocusManager) *Box {
+	b.focusManager = fm
 	return b
 }
 
+// SetDontClear is the same as SetBackgroundTransparent().
 func (b *Box) SetDontClear(dontClear bool) *Box {
 	b.dontClear = dontClear
 	return b
 }
 
+// SetBackgroundTransparent sets whether the box's background is left as is
+// instead of being filled with the background color, pattern, or gradient,
+// so that whatever was drawn underneath shows through, e.g. for overlay
+// panels. The border and the content are still drawn, the border cells
+// keeping the background color found on the screen. Flex and Grid are
+// transparent by default.
+//
+// This is the same setting as SetDontClear().
+func (b *Box) SetBackgroundTransparent(transparent bool) *Box {
+	b.dontClear = transparent
+	return b
+}
+
+// IsBackgroundTransparent returns whether the box's background is left as is,
+// see SetBackgroundTransparent().
+func (b *Box) IsBackgroundTransparent() bool {
+	return b.dontClear
+}
+
+// SetBackgroundGradient fills the background with a gradient instead of the
+// background color. If vertical is true, the color changes from the top color
+// in the first row to the bottom color in the last row. Otherwise, the top
+// color is used for the first column and the bottom color for the last column.
+// Colors are interpolated linearly in RGB space. Provide tcell.ColorDefault
+// for both colors to remove the gradient.
+//
+// A background pattern (see SetBackgroundPattern()) takes precedence over the
+// gradient.
+func (b *Box) SetBackgroundGradient(top, bottom tcell.Color, vertical bool) *Box {
+	b.gradient = top != tcell.ColorDefault || bottom != tcell.ColorDefault
+	b.gradientColors = [2]tcell.Color{top, bottom}
+	b.gradientVertical = vertical
+	return b
+}
+
+// drawBackgroundGradient fills the box's rect with the background gradient,
+// see SetBackgroundGradient().
+func (b *Box) drawBackgroundGradient(screen tcell.Screen, background tcell.Style) {
+	steps := b.width
+	if b.gradientVertical {
+		steps = b.height
+	}
+	for y := b.y; y < b.y+b.height; y++ {
+		for x := b.x; x < b.x+b.width; x++ {
+			step := x - b.x
+			if b.gradientVertical {
+				step = y - b.y
+			}
+			color := b.gradientColors[0]
+			if steps > 1 {
+				color = blendColors(b.gradientColors[0], b.gradientColors[1], float64(step)/float64(steps-1))
+			}
+			screen.SetContent(x, y, ' ', nil, background.Background(color))
+		}
+	}
+}
+
+// SetUseTerminalBackground sets whether the box's background is left untouched
+// if its background color is tcell.ColorDefault. Filling the background, even
+// with the default color, overwrites a terminal's background image or
+// transparency; with this option enabled, those show through instead. Borders
+// and titles are still drawn. This has no effect if a background pattern is
+// set.
+func (b *Box) SetUseTerminalBackground(use bool) *Box {
+	b.useTerminalBackground = use
+	return b
+}
+
 // SetBorderPadding sets the size of the borders around the box content.
+//
+// Padding values may be negative, in which case the inner rect (see
+// GetInnerRect()) grows by that amount on the respective side, extending over
+// the border and, if the padding exceeds the border, beyond the box's rect.
+// This allows subclasses to draw content bleeding over their frame. Note that
+// nothing is clipped to the box: content drawn there will overwrite the border
+// and whatever was drawn next to the box, and may be overwritten by primitives
+// drawn later.
 func (b *Box) SetBorderPadding(top, bottom, left, right int) *Box {
 	b.paddingTop, b.paddingBottom, b.paddingLeft, b.paddingRight = top, bottom, left, right
 	return b
 }
 
+// GetBorderPadding returns the size of the padding around the box content, as
+// set with SetBorderPadding().
+func (b *Box) GetBorderPadding() (top, bottom, left, right int) {
+	return b.paddingTop, b.paddingBottom, b.paddingLeft, b.paddingRight
+}
+
 // SetVisible sets whether the Box should be drawn onto the screen.
 func (b *Box) SetVisible(visible bool) {
 	b.visible = visible
@@ -173,17 +669,20 @@ func (b *Box) SetOnBlur(handler func()) {
 // GetInnerRect returns the position of the inner rectangle (x, y, width,
 // height), without the border and without any padding. Width and height values
 // will clamp to 0 and thus never be negative.
+//
+// Negative padding (see SetBorderPadding()) grows the inner rectangle beyond
+// the border instead of shrinking it.
 func (b *Box) GetInnerRect() (int, int, int, int) {
 	if b.innerX >= 0 {
 		return b.innerX, b.innerY, b.innerWidth, b.innerHeight
 	}
 	x, y, width, height := b.GetRect()
-	if b.border {
-		x += boolToInt(b.borderLeft)
-		y += boolToInt(b.borderTop)
-		width -= boolToInt(b.borderLeft) + boolToInt(b.borderRight)
-		height -= boolToInt(b.borderTop) + boolToInt(b.borderBottom)
-	}
+	top, bottom, left, right := b.borderWidths()
+	x += left
+	y += top
+	width -= left + right
+	height -= top + bottom
+	// Padding shrinks the rect. Negative padding grows it past the border.
 	x, y, width, height = x+b.paddingLeft,
 		y+b.paddingTop,
 		width-b.paddingLeft-b.paddingRight,
@@ -197,6 +696,23 @@ func (b *Box) GetInnerRect() (int, int, int, int) {
 	return x, y, width, height
 }
 
+// borderWidths returns the number of cells taken by the border on each side,
+// taking the border thickness and the rows of a wrapped title (see
+// SetTitleWrap()) into account. All values are 0 if there is no border.
+func (b *Box) borderWidths() (top, bottom, left, right int) {
+	if !b.border {
+		return
+	}
+	if lines, _ := b.titleLines(); len(lines) > 1 {
+		top = len(lines) - 1
+	}
+	rings := b.borderThickness + boolToInt(b.doubleFrame)
+	return top + rings*boolToInt(b.borderTop),
+		rings * boolToInt(b.borderBottom),
+		rings * boolToInt(b.borderLeft),
+		rings * boolToInt(b.borderRight)
+}
+
 func boolToInt(b bool) int {
 	if b {
 		return 1
@@ -205,28 +721,335 @@ func boolToInt(b bool) int {
 	return 0
 }
 
-// SetRect sets a new position of the primitive. Note that this has no effect
-// if this primitive is part of a layout (e.g. Flex, Grid) or if it was added
-// like this:
-//
-//	application.SetRoot(b, true)
+// Event calls the given function with the box's evented function (see
+// SetEventedFunc()), if there is one, so that it can send an event. If an
+// event debounce interval is set (see SetEventDebounce()), the event is
+// delayed instead.
 func (b *Box) Event(f EventerFunc) {
-  if b.evented != nil {
-    f(b.evented)
-  }
+	if b.evented != nil {
+		if b.eventDebounce != nil {
+			f(b.eventDebounce.send)
+			return
+		}
+		f(b.evented)
+	}
 }
 
+// eventDebouncer delays events sent by a box, see Box.SetEventDebounce().
+type eventDebouncer struct {
+	sync.Mutex
+
+	// The box whose evented function receives the events.
+	box *Box
+
+	// The interval during which repeated events are collapsed.
+	interval time.Duration
+
+	// The timers of the pending events and the functions sending them, by
+	// event name.
+	timers  map[EventName]*time.Timer
+	pending map[EventName]func()
+}
+
+// send delays the given event until no event with the same name was sent for
+// the debounce interval, replacing the pending one. The event goes to the
+// evented function installed when it was sent, so the timer goroutine never
+// reads the box's evented function.
+func (d *eventDebouncer) send(event EventName, p Primitive, i ...any) {
+	d.Lock()
+	defer d.Unlock()
+	evented := d.box.evented
+	d.pending[event] = func() {
+		if evented != nil {
+			evented(event, p, i...)
+		}
+	}
+	if timer, ok := d.timers[event]; ok {
+		timer.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(d.interval, func() {
+		d.Lock()
+		if d.timers[event] != timer {
+			d.Unlock()
+			return // A later event replaced this one.
+		}
+		emit := d.pending[event]
+		delete(d.pending, event)
+		delete(d.timers, event)
+		d.Unlock()
+		emit()
+	})
+	d.timers[event] = timer
+}
+
+// SetEventDebounce collapses repeated events with the same name (e.g. many
+// EventSetRect events while the terminal is being resized) into a single
+// event, sent with the arguments of the last one once no event with that name
+// was sent for the given interval. Debounced events are sent to the evented
+// function (see SetEventedFunc()) from their own goroutine, so it must use
+// Application.QueueUpdate() or Application.QueueUpdateDraw() to access
+// primitives. A duration of 0 (the default) sends events immediately.
+//
+// Events which are pending when this function is called are still sent.
+func (b *Box) SetEventDebounce(interval time.Duration) *Box {
+	if interval <= 0 {
+		b.eventDebounce = nil
+		return b
+	}
+	b.eventDebounce = &eventDebouncer{
+		box:      b,
+		interval: interval,
+		timers:   make(map[EventName]*time.Timer),
+		pending:  make(map[EventName]func()),
+	}
+	return b
+}
+
+// SetRect sets a new position of the primitive. Width and height are raised
+// to the minimum size set with SetMinSize(), if any.
+//
+// If the rect changes, an EventSetRect event with the new position and size is
+// sent to the evented function (see SetEventedFunc()). If the size changes, an
+// EventResize event with the old and the new width and height follows.
+//
+// If the box is managed by a layout (see SetManaged()), only the layout may
+// change its rect. Calls from elsewhere are ignored.
 func (b *Box) SetRect(x, y, width, height int) {
-  if x != b.x || y != b.y || width != b.width || height != b.height {
-    b.Event(func(f EventedFunc) {
-      f("set.rect", b, x,y,width,height)
-    })
-  }
+	if b.managed && !b.layoutPass {
+		return
+	}
+	if width < b.minWidth {
+		width = b.minWidth
+	}
+	if height < b.minHeight {
+		height = b.minHeight
+	}
+	if x != b.x || y != b.y || width != b.width || height != b.height {
+		b.Event(func(f EventedFunc) {
+			f(EventSetRect, b, x, y, width, height)
+		})
+	}
+	resized := width != b.width || height != b.height
+	if resized {
+		oldWidth, oldHeight := b.width, b.height
+		b.Event(func(f EventedFunc) {
+			f(EventResize, b, oldWidth, oldHeight, width, height)
+		})
+	}
 	b.x = x
 	b.y = y
 	b.width = width
 	b.height = height
 	b.innerX = -1 // Mark inner rect as uninitialized.
+	if resized {
+		b.resized(width, height)
+	}
+}
+
+// SetManaged sets whether the box's rect is managed by a layout such as Flex
+// or Grid. Layouts call this when the box is added to or removed from them.
+// While the box is managed, SetRect() has no effect unless it is called by
+// the layout, so that the box doesn't flicker between a rect set by the
+// application and the one set by the layout.
+func (b *Box) SetManaged(managed bool) *Box {
+	b.managed = managed
+	return b
+}
+
+// IsManaged returns whether the box's rect is managed by a layout.
+func (b *Box) IsManaged() bool {
+	return b.managed
+}
+
+// layoutBox returns the box itself. It lets layouts reach the Box embedded in
+// their items.
+func (b *Box) layoutBox() *Box {
+	return b
+}
+
+// setManagedItem marks the given layout item as managed or unmanaged if it is
+// based on a Box, see Box.SetManaged().
+func setManagedItem(item Primitive, managed bool) {
+	if boxed, ok := item.(interface{ layoutBox() *Box }); ok {
+		boxed.layoutBox().SetManaged(managed)
+	}
+}
+
+// adoptItem marks the given item as managed by the given layout and makes the
+// layout its parent, see SetParent(). Layouts call it for items they add.
+func adoptItem(item, layout Primitive) {
+	if item == nil {
+		return
+	}
+	setManagedItem(item, true)
+	item.SetParent(layout)
+}
+
+// releaseItem reverts adoptItem() for an item removed from the given layout.
+// The item's parent is only cleared if it is still the layout.
+func releaseItem(item, layout Primitive) {
+	if item == nil {
+		return
+	}
+	setManagedItem(item, false)
+	if item.GetParent() == layout {
+		item.SetParent(nil)
+	}
+}
+
+// setItemRect sets the rect of the given layout item on behalf of its layout,
+// even if the item is managed.
+func setItemRect(item Primitive, x, y, width, height int) {
+	if boxed, ok := item.(interface{ layoutBox() *Box }); ok {
+		box := boxed.layoutBox()
+		box.layoutPass = true
+		defer func() {
+			box.layoutPass = false
+		}()
+	}
+	item.SetRect(x, y, width, height)
+}
+
+// SetRectPercent sets the position and size of the box in percent (0 to 100)
+// of the screen's width and height. They are resolved against the screen size
+// whenever the box is drawn and take priority over the rect set with SetRect(),
+// e.g. by a layout, until ClearRectPercent() is called. For example, a modal
+// centered on the screen:
+//
+//	box.SetRectPercent(25, 25, 50, 50)
+func (b *Box) SetRectPercent(xPct, yPct, wPct, hPct float64) *Box {
+	b.rectPercent = &[4]float64{xPct, yPct, wPct, hPct}
+	return b
+}
+
+// ClearRectPercent removes the percentages set with SetRectPercent(). The box
+// keeps its last resolved rect until SetRect() is called.
+func (b *Box) ClearRectPercent() *Box {
+	b.rectPercent = nil
+	return b
+}
+
+// SetOnResize sets a handler which is called from SetRect() whenever the
+// box's size changes. As it may be called for every frame while the terminal
+// is being resized, it should be cheap. See SetOnResizeComplete() for
+// expensive reactions.
+func (b *Box) SetOnResize(handler func(width, height int)) *Box {
+	b.onResize = handler
+	return b
+}
+
+// SetOnResizeComplete sets a handler which is called once the box's size has
+// not changed for a while (see SetResizeCompleteDelay()), e.g. to reflow
+// content or to fetch data for the new size. The handler is called from its
+// own goroutine, so it must use Application.QueueUpdate() or
+// Application.QueueUpdateDraw() to access primitives.
+func (b *Box) SetOnResizeComplete(handler func(width, height int)) *Box {
+	b.onResizeComplete = handler
+	return b
+}
+
+// SetResizeCompleteDelay sets how long the box's size must remain unchanged
+// before the handler installed with SetOnResizeComplete() is called. The
+// default is 250 milliseconds.
+func (b *Box) SetResizeCompleteDelay(delay time.Duration) *Box {
+	b.resizeCompleteDelay = delay
+	return b
+}
+
+// resized calls the resize handlers for the given new size.
+func (b *Box) resized(width, height int) {
+	if b.onResize != nil {
+		b.onResize(width, height)
+	}
+	if b.onResizeComplete == nil {
+		return
+	}
+	if b.resizeCompleteTimer != nil {
+		b.resizeCompleteTimer.Stop()
+	}
+	delay := b.resizeCompleteDelay
+	if delay <= 0 {
+		delay = 250 * time.Millisecond
+	}
+	handler := b.onResizeComplete
+	b.resizeCompleteTimer = time.AfterFunc(delay, func() {
+		handler(width, height)
+	})
+}
+
+// SetMeasureFunc sets a function which returns the size the box wants to have,
+// e.g. the size of its content, given the available width and height. It is
+// called by Measure(), which layouts may use to size the box. Results larger
+// than the available space are reduced to it, negative results are raised to
+// 0. Provide nil to remove a previously installed function.
+func (b *Box) SetMeasureFunc(measure func(maxWidth, maxHeight int) (width, height int)) *Box {
+	b.measure = measure
+	return b
+}
+
+// Measure returns the size the box wants to have within the given available
+// width and height, as determined by the function set with SetMeasureFunc().
+// Without such a function, the box's current width and height are returned.
+// Either way, the result is clamped to between 0 and the available size. See
+// Measurer.
+func (b *Box) Measure(maxWidth, maxHeight int) (width, height int) {
+	width, height = b.width, b.height
+	if b.measure != nil {
+		width, height = b.measure(maxWidth, maxHeight)
+	}
+	if width > maxWidth {
+		width = maxWidth
+	}
+	if height > maxHeight {
+		height = maxHeight
+	}
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	return
+}
+
+// SetMinSize sets the minimum width and height of the box. SetRect() will not
+// make the box smaller than this, even if a layout provides less space. Layouts
+// may query the minimum size with GetMinSize() to allocate space accordingly.
+// A value of 0 (the default) means there is no minimum.
+//
+// If the box is managed by a layout (see SetManaged()), its rect is not
+// changed immediately. The minimum size applies when the layout positions the
+// box the next time it is drawn.
+func (b *Box) SetMinSize(minWidth, minHeight int) *Box {
+	b.minWidth, b.minHeight = minWidth, minHeight
+	if b.width < minWidth || b.height < minHeight {
+		b.SetRect(b.x, b.y, b.width, b.height)
+	}
+	return b
+}
+
+// GetMinSize returns the minimum width and height set with SetMinSize().
+func (b *Box) GetMinSize() (minWidth, minHeight int) {
+	return b.minWidth, b.minHeight
+}
+
+// SetWidth changes the width of the box, keeping its position and height. See
+// SetRect() for details. This has no effect if the box is managed by a layout
+// (see SetManaged()); change its size through the layout instead, e.g. with
+// Flex.ResizeItem().
+func (b *Box) SetWidth(width int) *Box {
+	b.SetRect(b.x, b.y, width, b.height)
+	return b
+}
+
+// SetHeight changes the height of the box, keeping its position and width. See
+// SetRect() for details. This has no effect if the box is managed by a layout
+// (see SetManaged()); change its size through the layout instead, e.g. with
+// Flex.ResizeItem().
+func (b *Box) SetHeight(height int) *Box {
+	b.SetRect(b.x, b.y, b.width, height)
+	return b
 }
 
 // SetDrawFunc sets a callback function which is invoked after the box primitive
@@ -249,6 +1072,25 @@ func (b *Box) SetDrawFunc(
 func (b *Box) GetDrawFunc() func(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
 	return b.draw
 }
+
+// SetOnBeforeDraw sets a callback function which is invoked before anything
+// of the box (or its subclass) is drawn. This allows you to synchronize state
+// right before drawing. If the function returns true, drawing is skipped for
+// this frame, leaving the screen area of the box untouched.
+//
+// Unlike the function installed with SetDrawFunc(), this function does not
+// affect the inner rect. Provide nil to remove a previously installed
+// function.
+func (b *Box) SetOnBeforeDraw(handler func(screen tcell.Screen) (skip bool)) *Box {
+	b.beforeDraw = handler
+	return b
+}
+
+// GetOnBeforeDraw returns the callback function which was installed with
+// SetOnBeforeDraw() or nil if no such function has been installed.
+func (b *Box) GetOnBeforeDraw() func(screen tcell.Screen) (skip bool) {
+	return b.beforeDraw
+}
 func (b *Box) SetEventedFunc(
 	handler EventedFunc,
 ) *Box {
@@ -271,17 +1113,163 @@ func (b *Box) WrapInputHandler(
 	inputHandler func(*tcell.EventKey, func(p Primitive)),
 ) func(*tcell.EventKey, func(p Primitive)) {
 	return func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		if b.disabled || b.bubbling {
+			return
+		}
 		if b.inputCapture != nil {
 			event = b.inputCapture(event)
 		}
+		if event != nil {
+			var consumed bool
+			for _, binding := range b.keyBindings {
+				if binding.matches(event) && binding.handler() {
+					consumed = true
+				}
+			}
+			if consumed {
+				return
+			}
+		}
 		if event != nil && inputHandler != nil {
 			inputHandler(event, setFocus)
+		} else if event != nil {
+			b.bubbleKey(event, setFocus)
 		}
 
 		// return event
 	}
 }
 
+// WrapConsumingInputHandler is like WrapInputHandler() but the provided
+// (default) input handler reports whether it consumed the event. Events it
+// didn't consume are passed on to the parent if the box bubbles unhandled keys
+// (see SetBubbleUnhandledKeys()).
+//
+// This is only meant to be used by subclassing primitives.
+func (b *Box) WrapConsumingInputHandler(
+	inputHandler func(*tcell.EventKey, func(p Primitive)) (consumed bool),
+) func(*tcell.EventKey, func(p Primitive)) {
+	return b.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		if !inputHandler(event, setFocus) {
+			b.bubbleKey(event, setFocus)
+		}
+	})
+}
+
+// SetBubbleUnhandledKeys sets whether key events which the box doesn't handle
+// are passed on to the input handler of its parent (see SetParent()), e.g. to
+// have a container react to shortcuts its focused child ignores. A key is
+// unhandled if the box has no default input handler (as with a plain Box) or
+// if its handler was wrapped with WrapConsumingInputHandler() and reported
+// the key as not consumed.
+//
+// While the parent handles the key, the box ignores key events, so that a
+// parent which forwards keys to its focused child doesn't send the key back.
+// Note that the parent's input capture function (see SetInputCapture()) sees
+// the key a second time.
+func (b *Box) SetBubbleUnhandledKeys(bubble bool) *Box {
+	b.bubbleKeys = bubble
+	return b
+}
+
+// bubbleKey passes an unhandled key event on to the parent's input handler if
+// the box bubbles unhandled keys.
+func (b *Box) bubbleKey(event *tcell.EventKey, setFocus func(p Primitive)) {
+	if !b.bubbleKeys || b.parent == nil {
+		return
+	}
+	handler := b.parent.InputHandler()
+	if handler == nil {
+		return
+	}
+	b.bubbling = true
+	defer func() {
+		b.bubbling = false
+	}()
+	handler(event, setFocus)
+}
+
+// ConsumesKey returns whether a handler was registered for the given key event
+// with SetOnKey(), SetOnRune(), BindKey(), or BindRune(). See KeyConsumer.
+func (b *Box) ConsumesKey(event *tcell.EventKey) bool {
+	for _, binding := range b.keyBindings {
+		if binding.matches(event) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetOnKey registers a handler which is called when the given key is pressed
+// while the box has focus. The key event is consumed, i.e. it is not forwarded
+// to the primitive's default input handler. Handlers are consulted after the
+// input capture function (see SetInputCapture()). Multiple handlers may be
+// registered, also for the same key, in which case all of them are called in
+// the order they were registered. For character keys, use SetOnRune().
+func (b *Box) SetOnKey(key tcell.Key, handler func()) *Box {
+	b.keyBindings = append(b.keyBindings, &keyBinding{key: key, handler: func() bool {
+		handler()
+		return true
+	}})
+	return b
+}
+
+// SetOnRune registers a handler which is called when the given character is
+// typed while the box has focus. See SetOnKey() for details.
+func (b *Box) SetOnRune(r rune, handler func()) *Box {
+	b.keyBindings = append(b.keyBindings, &keyBinding{key: tcell.KeyRune, r: r, handler: func() bool {
+		handler()
+		return true
+	}})
+	return b
+}
+
+// BindKey registers a handler which is called when the given key is pressed
+// with exactly the given modifiers while the box has focus. If the handler
+// returns true, the key event is consumed, i.e. it is not forwarded to the
+// primitive's default input handler. Otherwise, the event is forwarded
+// unless another handler for the key consumes it. Bindings are consulted in
+// the same way as those registered with SetOnKey(). For character keys, use
+// BindRune().
+func (b *Box) BindKey(key tcell.Key, mod tcell.ModMask, handler func() bool) *Box {
+	b.keyBindings = append(b.keyBindings, &keyBinding{key: key, mod: mod, modSet: true, handler: handler})
+	return b
+}
+
+// BindRune registers a handler which is called when the given character is
+// typed with exactly the given modifiers while the box has focus. See
+// BindKey() for details.
+func (b *Box) BindRune(r rune, mod tcell.ModMask, handler func() bool) *Box {
+	b.keyBindings = append(b.keyBindings, &keyBinding{key: tcell.KeyRune, r: r, mod: mod, modSet: true, handler: handler})
+	return b
+}
+
+// UnbindKey removes all handlers registered with BindKey() for the given key
+// and modifiers.
+func (b *Box) UnbindKey(key tcell.Key, mod tcell.ModMask) *Box {
+	return b.unbind(&keyBinding{key: key, mod: mod, modSet: true})
+}
+
+// UnbindRune removes all handlers registered with BindRune() for the given
+// character and modifiers.
+func (b *Box) UnbindRune(r rune, mod tcell.ModMask) *Box {
+	return b.unbind(&keyBinding{key: tcell.KeyRune, r: r, mod: mod, modSet: true})
+}
+
+// unbind removes all bindings with the same key, character, and modifiers as
+// the given one.
+func (b *Box) unbind(binding *keyBinding) *Box {
+	bindings := b.keyBindings[:0]
+	for _, existing := range b.keyBindings {
+		if existing.key != binding.key || existing.modSet != binding.modSet || existing.mod != binding.mod ||
+			existing.key == tcell.KeyRune && existing.r != binding.r {
+			bindings = append(bindings, existing)
+		}
+	}
+	b.keyBindings = bindings
+	return b
+}
+
 // InputHandler returns nil.
 func (b *Box) InputHandler() func(*tcell.EventKey, func(p Primitive)) {
 	return b.WrapInputHandler(nil)
@@ -314,7 +1302,11 @@ func (b *Box) GetInputCapture() func(event *tcell.EventKey) *tcell.EventKey {
 	return b.inputCapture
 }
 
-// SetMouseHandler sets the mouse event handler.
+// SetMouseHandler sets a function which is called with mouse events inside the
+// box, after the mouse capture function (see SetMouseCapture()) and before the
+// primitive's default mouse handler. If it returns true, the event is
+// consumed and not passed on to the default handler. Provide nil to remove a
+// previously installed function.
 func (b *Box) SetMouseHandler(handler func(event *tcell.EventMouse) bool) {
 	b.mouseHandler = handler
 }
@@ -328,9 +1320,40 @@ func (b *Box) WrapMouseHandler(
 	mouseHandler func(MouseAction, *tcell.EventMouse, func(p Primitive)) (bool, Primitive),
 ) func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
 	return func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		if b.disabled {
+			return false, nil
+		}
 		if b.mouseCapture != nil {
 			action, event = b.mouseCapture(action, event)
 		}
+		if event != nil && action == MouseLeftDoubleClick && b.onDoubleClick != nil && b.InRect(event.Position()) {
+			b.onDoubleClick(event.Position())
+			return true, nil
+		}
+		if event != nil && action == MouseLeftClick && b.overflowScroll != nil {
+			if fraction, ok := b.overflowFraction(event.Position()); ok {
+				b.overflowScroll(fraction)
+				return true, nil
+			}
+		}
+		if event != nil && action == MouseLeftClick && b.titleTabClick != nil {
+			if index := b.titleTabAt(event.Position()); index >= 0 {
+				b.titleTabActive = index
+				b.titleTabClick(index)
+				return true, nil
+			}
+		}
+		if event != nil && action == MouseLeftClick && b.onTitleClick != nil {
+			x, y := event.Position()
+			titleX, titleWidth := b.titleSpan()
+			if y == b.y && x >= titleX && x < titleX+titleWidth {
+				b.onTitleClick()
+				return true, nil
+			}
+		}
+		if event != nil && b.mouseHandler != nil && b.InRect(event.Position()) && b.mouseHandler(event) {
+			return true, nil
+		}
 		if event != nil && mouseHandler != nil {
 			consumed, capture = mouseHandler(action, event, setFocus)
 		}
@@ -338,19 +1361,116 @@ func (b *Box) WrapMouseHandler(
 	}
 }
 
-// MouseHandler returns nil.
+// MouseHandler returns the box's default mouse handler, which focuses the box
+// when it is clicked and calls the handlers set with SetRightClickHandler()
+// and SetScrollHandler() when it is right-clicked or scrolled.
 func (b *Box) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
 	return b.WrapMouseHandler(
 		func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
-			if action == MouseLeftClick && b.InRect(event.Position()) {
+			if action == MouseMove {
+				b.updateHover(b.InRect(event.Position()))
+			}
+			if !b.InRect(event.Position()) {
+				return
+			}
+			switch action {
+			case MouseLeftClick:
 				setFocus(b)
 				consumed = true
+			case MouseRightClick:
+				if b.rightClick != nil {
+					consumed = b.rightClick(event.Position())
+				}
+			case MouseScrollUp:
+				if b.scroll != nil {
+					consumed = b.scroll(-1)
+				}
+			case MouseScrollDown:
+				if b.scroll != nil {
+					consumed = b.scroll(1)
+				}
 			}
 			return
 		},
 	)
 }
 
+// SetMouseEnterHandler sets a function which is called by the default mouse
+// handler when the mouse pointer moves into the box.
+func (b *Box) SetMouseEnterHandler(handler func()) *Box {
+	b.mouseEnter = handler
+	return b
+}
+
+// SetMouseLeaveHandler sets a function which is called by the default mouse
+// handler when the mouse pointer moves out of the box. Note that the box only
+// notices this if it still receives the mouse movement, e.g. from a Flex,
+// which passes mouse events on to its items until one consumes them.
+func (b *Box) SetMouseLeaveHandler(handler func()) *Box {
+	b.mouseLeave = handler
+	return b
+}
+
+// updateHover records whether the mouse pointer is inside the box and calls
+// the enter or leave handler if this changed.
+func (b *Box) updateHover(inside bool) {
+	if inside == b.hovered {
+		return
+	}
+	b.hovered = inside
+	if inside && b.mouseEnter != nil {
+		b.mouseEnter()
+	} else if !inside && b.mouseLeave != nil {
+		b.mouseLeave()
+	}
+}
+
+// SetOnDoubleClick sets a handler which is called with the mouse position
+// when the user double-clicks the box with the left mouse button, i.e. clicks
+// twice within DoubleClickInterval without moving the mouse. The first click
+// is still delivered as a regular click (which usually focuses the box).
+// Double-clicks handled this way are consumed and not passed on to the
+// primitive's mouse handler.
+//
+// The handler is called after the mouse capture function (see
+// SetMouseCapture()), which may therefore intercept double-clicks by
+// returning a nil event or a different action.
+func (b *Box) SetOnDoubleClick(handler func(x, y int)) *Box {
+	b.onDoubleClick = handler
+	return b
+}
+
+// SetDoubleClickHandler sets a handler which is called when the box is
+// double-clicked with the left mouse button. It is a shorthand for
+// SetOnDoubleClick() for handlers which don't need the mouse position and
+// replaces the handler set there (and vice versa).
+func (b *Box) SetDoubleClickHandler(handler func()) *Box {
+	if handler == nil {
+		return b.SetOnDoubleClick(nil)
+	}
+	return b.SetOnDoubleClick(func(x, y int) {
+		handler()
+	})
+}
+
+// SetRightClickHandler sets a handler which is called by the box's default
+// mouse handler (see MouseHandler()) with the mouse position when the box is
+// clicked with the right mouse button, e.g. to open a context menu there. The
+// handler returns whether it consumed the event.
+func (b *Box) SetRightClickHandler(handler func(x, y int) bool) *Box {
+	b.rightClick = handler
+	return b
+}
+
+// SetScrollHandler sets a handler which is called by the box's default mouse
+// handler (see MouseHandler()) for each notch the mouse wheel is turned while
+// the mouse is over the box, with a delta of -1 for scrolling up and 1 for
+// scrolling down. The handler returns whether it consumed the event.
+func (b *Box) SetScrollHandler(handler func(delta int) bool) *Box {
+	b.scroll = handler
+	return b
+}
+
 // SetMouseCapture sets a function which captures mouse events (consisting of
 // the original tcell mouse event and the semantic mouse action) before they are
 // forwarded to the primitive's default mouse event handler. This function can
@@ -382,6 +1502,17 @@ func (b *Box) GetMouseCapture() func(action MouseAction, event *tcell.EventMouse
 // SetBackgroundColor sets the box's background color.
 func (b *Box) SetBackgroundColor(color tcell.Color) *Box {
 	b.backgroundColor = color
+	b.themeColors &^= themeBackground
+	return b
+}
+
+// SetBackgroundPattern fills the box's background by repeating the given runes
+// in the given colors, e.g. []rune("·  ") for a subtle dot grid. The pattern
+// restarts at the left edge of each row. A nil or empty pattern reverts to a
+// solid fill with the background color.
+func (b *Box) SetBackgroundPattern(pattern []rune, fg, bg tcell.Color) *Box {
+	b.backgroundPattern = pattern
+	b.patternStyle = tcell.StyleDefault.Foreground(fg).Background(bg)
 	return b
 }
 
@@ -413,6 +1544,14 @@ func (b *Box) SetBorderStyle(bs *BorderStyle) *Box {
 	return b
 }
 
+// UseRoundedBorders draws the box's border with rounded corners, using a copy
+// of BordersRounded.
+func (b *Box) UseRoundedBorders() *Box {
+	borders := BordersRounded
+	b.borderStyles = &borders
+	return b
+}
+
 // GetBorderAttributes returns the border's style attributes.
 func (b *Box) GetBorderAttributes() tcell.AttrMask {
 	_, _, attr := b.borderStyle.Decompose()
@@ -432,6 +1571,7 @@ func (b *Box) SetBorderVisible(visible bool) *Box {
 // SetBorderColor sets the box's border color.
 func (b *Box) SetBorderColor(color tcell.Color) *Box {
 	b.borderColor = color
+	b.themeColors &^= themeBorder
 	return b
 }
 
@@ -446,12 +1586,74 @@ func (b *Box) GetBackgroundColor() tcell.Color {
 	return b.backgroundColor
 }
 
+// ContrastingColor returns black or white, whichever is more readable on the
+// box's background color. See ContrastingColor() for details.
+func (b *Box) ContrastingColor() tcell.Color {
+	return ContrastingColor(b.backgroundColor)
+}
+
 // SetBorderFocusColor sets the box's border color when focused.
 func (b *Box) SetBorderFocusColor(color tcell.Color) *Box {
 	b.borderFocusColor = color
+	b.themeColors &^= themeBorderFocus
+	return b
+}
+
+// SetBorderFocusFollowsChildren sets whether the border is drawn as if the box
+// had focus (see SetBorderFocusColor()) while any primitive contained in it
+// has focus, e.g. to keep a panel highlighted while one of its fields is being
+// edited. This relies on the HasFocus() function of the primitive drawing the
+// box, which containers such as Flex and Grid implement by asking their
+// children.
+func (b *Box) SetBorderFocusFollowsChildren(follow bool) *Box {
+	b.borderFocusFollowsChildren = follow
 	return b
 }
 
+// SetBorderFocusFade makes the border color fade between the border color and
+// the focus color (see SetBorderFocusColor()) over the given duration when the
+// box receives or loses focus, instead of switching instantly. If the focus
+// changes again during a fade, the color fades back from where it is. A
+// duration of 0 (the default) turns fading off.
+//
+// The fade runs on DefaultAnimator (see Animate()). Colors which are not RGB
+// colors cannot be blended and switch halfway through the fade.
+func (b *Box) SetBorderFocusFade(duration time.Duration) *Box {
+	b.borderFocusFade = duration
+	return b
+}
+
+// fadeBorder fades the border color towards the focus color (if focused is
+// true) or the border color (if false), cancelling a running fade.
+func (b *Box) fadeBorder(focused bool) {
+	if b.borderFadeAnimation != nil {
+		DefaultAnimator.Stop(b.borderFadeAnimation)
+		b.borderFadeAnimation = nil
+	}
+	to := 0.0
+	if focused {
+		to = 1
+	}
+	from := b.borderFade
+	if b.borderFocusFade <= 0 || from == to || !DefaultAnimator.hasApplication() {
+		b.borderFade = to
+		return
+	}
+	var animation *Animation
+	animation = DefaultAnimator.Start(&Animation{
+		Duration: time.Duration(math.Abs(to-from) * float64(b.borderFocusFade)),
+		OnFrame: func(progress float64) {
+			b.borderFade = from + (to-from)*progress
+		},
+		OnDone: func() {
+			if b.borderFadeAnimation == animation {
+				b.borderFadeAnimation = nil
+			}
+		},
+	})
+	b.borderFadeAnimation = animation
+}
+
 // SetBorderSides decides which sides of the border should be shown in case the
 // border has been activated.
 func (b *Box) SetBorderSides(top, left, bottom, right bool) *Box {
@@ -463,6 +1665,117 @@ func (b *Box) SetBorderSides(top, left, bottom, right bool) *Box {
 	return b
 }
 
+// GetBorderSides returns which sides of the border are shown, as set with
+// SetBorderSides(). Unlike IsBorderTop() and the like, the result does not
+// depend on whether the border is activated (see SetBorder()).
+func (b *Box) GetBorderSides() (top, left, bottom, right bool) {
+	return b.borderTop, b.borderLeft, b.borderBottom, b.borderRight
+}
+
+// SetBorderSideColors sets the colors of the top, left, bottom, and right
+// border sides, overriding the border color (see SetBorderColor()) while the
+// box doesn't have focus. Corners take the color of the top or bottom side. Use
+// tcell.ColorDefault for sides drawn in the border color.
+func (b *Box) SetBorderSideColors(top, left, bottom, right tcell.Color) *Box {
+	b.borderSideColors = [4]tcell.Color{top, left, bottom, right}
+	return b
+}
+
+// BorderConfig describes the framing of a box: its border, title, and
+// padding. It is used with Box.ApplyBorderConfig() and Box.GetBorderConfig()
+// to configure many boxes consistently or to save and restore a box's
+// framing.
+type BorderConfig struct {
+	// Whether a border is drawn at all.
+	Border bool
+
+	// Which sides of the border are drawn.
+	Top, Left, Bottom, Right bool
+
+	// The border color, the border color while the box has focus, and the
+	// colors of the individual sides (tcell.ColorDefault to use Color).
+	Color, FocusColor                            tcell.Color
+	TopColor, LeftColor, BottomColor, RightColor tcell.Color
+
+	// The border glyphs (nil keeps the current glyphs), the style attributes,
+	// and the number of border rings (values below 1 are treated as 1).
+	Style      *BorderStyle
+	Attributes tcell.AttrMask
+	Thickness  int
+
+	// The title, its alignment, and its color.
+	Title      string
+	TitleAlign int
+	TitleColor tcell.Color
+
+	// The padding between the border and the inner rect.
+	PaddingTop, PaddingBottom, PaddingLeft, PaddingRight int
+}
+
+// ApplyBorderConfig configures the box's border, title, and padding at once.
+// It is equivalent to calling the individual setters (SetBorder(),
+// SetBorderSides(), SetBorderColor(), SetTitle(), SetBorderPadding(), and so
+// on) with the values of the given configuration.
+func (b *Box) ApplyBorderConfig(config BorderConfig) *Box {
+	b.SetBorder(config.Border).
+		SetBorderSides(config.Top, config.Left, config.Bottom, config.Right).
+		SetBorderColor(config.Color).
+		SetBorderFocusColor(config.FocusColor).
+		SetBorderSideColors(config.TopColor, config.LeftColor, config.BottomColor, config.RightColor).
+		SetBorderAttributes(config.Attributes).
+		SetBorderThickness(config.Thickness).
+		SetTitleExt(config.Title, config.TitleAlign, config.TitleColor).
+		SetBorderPadding(config.PaddingTop, config.PaddingBottom, config.PaddingLeft, config.PaddingRight)
+	if config.Style != nil {
+		b.SetBorderStyle(config.Style)
+	}
+	return b
+}
+
+// GetBorderConfig returns the box's current border, title, and padding
+// configuration. Passing it to ApplyBorderConfig() restores the framing.
+func (b *Box) GetBorderConfig() BorderConfig {
+	return BorderConfig{
+		Border:        b.border,
+		Top:           b.borderTop,
+		Left:          b.borderLeft,
+		Bottom:        b.borderBottom,
+		Right:         b.borderRight,
+		Color:         b.borderColor,
+		FocusColor:    b.borderFocusColor,
+		TopColor:      b.borderSideColors[0],
+		LeftColor:     b.borderSideColors[1],
+		BottomColor:   b.borderSideColors[2],
+		RightColor:    b.borderSideColors[3],
+		Style:         b.borderStyles,
+		Attributes:    b.GetBorderAttributes(),
+		Thickness:     b.borderThickness,
+		Title:         b.title,
+		TitleAlign:    b.titleAlign,
+		TitleColor:    b.titleColor,
+		PaddingTop:    b.paddingTop,
+		PaddingBottom: b.paddingBottom,
+		PaddingLeft:   b.paddingLeft,
+		PaddingRight:  b.paddingRight,
+	}
+}
+
+// SetBorderCorner overrides the glyph of one corner of the border, one of the
+// BorderCorner constants, without changing the border style shared with other
+// boxes, e.g. to join adjacent boxes with T-junctions. Provide 0 to use the
+// border style's glyph again.
+func (b *Box) SetBorderCorner(corner int, r rune) *Box {
+	if r == 0 {
+		delete(b.borderCorners, corner)
+		return b
+	}
+	if b.borderCorners == nil {
+		b.borderCorners = make(map[int]rune)
+	}
+	b.borderCorners[corner] = r
+	return b
+}
+
 // IsBorder indicates whether a border is rendered at all.
 func (b *Box) IsBorder() bool {
 	return b.border
@@ -492,20 +1805,167 @@ func (b *Box) IsBorderLeft() bool {
 	return b.border && b.borderLeft
 }
 
+// SetBorderThickness sets the number of concentric rings the border is drawn
+// with, for a heavier frame on terminals without heavy box-drawing glyphs. Each
+// ring takes one cell per bordered side from the inner rect. Rings which don't
+// fit into the box are omitted. The default is 1.
+func (b *Box) SetBorderThickness(thickness int) *Box {
+	if thickness < 1 {
+		thickness = 1
+	}
+	b.borderThickness = thickness
+	return b
+}
+
+// GetBorderThickness returns the number of border rings.
+func (b *Box) GetBorderThickness() int {
+	return b.borderThickness
+}
+
+// SetDoubleFrame sets whether a second frame is drawn one cell inside the
+// border, for a "frame within a frame" look. It uses the same glyphs as the
+// border and takes one more cell per bordered side from the inner rect. It is
+// only drawn if the box has a border (see SetBorder()).
+func (b *Box) SetDoubleFrame(double bool) *Box {
+	b.doubleFrame = double
+	return b
+}
+
+// SetInnerFrameColor sets the color of the second frame drawn with
+// SetDoubleFrame(). tcell.ColorDefault (the default) uses the border's
+// colors.
+func (b *Box) SetInnerFrameColor(color tcell.Color) *Box {
+	b.innerFrameColor = color
+	return b
+}
+
+// SetDimWhenUnfocused sets whether the box is drawn dimmed while neither it nor
+// any of its children has focus. The background is drawn with the dim
+// attribute and the border color is darkened.
+func (b *Box) SetDimWhenUnfocused(dim bool) *Box {
+	b.dimUnfocused = dim
+	return b
+}
+
+// SetShadow sets whether a one-cell drop shadow is drawn along the right and
+// bottom edges just outside the box, e.g. for modal dialogs. The shadow keeps
+// the characters underneath it but changes their background to the shadow
+// color (see SetShadowColor()). It is not part of the box's rect, so there
+// should be some room around the box for it to be visible.
+func (b *Box) SetShadow(shadow bool) *Box {
+	b.shadow = shadow
+	return b
+}
+
+// SetShadowColor sets the background color of the drop shadow. The default is
+// black.
+func (b *Box) SetShadowColor(color tcell.Color) *Box {
+	b.shadowColor = color
+	return b
+}
+
+// drawShadow draws the drop shadow, see SetShadow().
+func (b *Box) drawShadow(screen tcell.Screen) {
+	screenWidth, screenHeight := screen.Size()
+	shade := func(x, y int) {
+		if x < 0 || y < 0 || x >= screenWidth || y >= screenHeight {
+			return
+		}
+		mainc, combc, style, _ := screen.GetContent(x, y)
+		screen.SetContent(x, y, mainc, combc, style.Background(b.shadowColor).Dim(true))
+	}
+	right, bottom := b.x+b.width, b.y+b.height
+	for y := b.y + 1; y <= bottom; y++ {
+		shade(right, y)
+	}
+	for x := b.x + 1; x < right; x++ {
+		shade(x, bottom)
+	}
+}
+
+// SetFocusGlow sets whether a second, dimmed border is drawn in the focus
+// color just outside the box's rectangle while the box has focus. The glow
+// ring is clipped to the screen and doesn't take any space from the box, so
+// there should be some room around the box for it to be visible.
+func (b *Box) SetFocusGlow(glow bool) *Box {
+	b.focusGlow = glow
+	return b
+}
+
+// drawFocusGlow draws the glow ring around the box, see SetFocusGlow().
+func (b *Box) drawFocusGlow(screen tcell.Screen, background tcell.Style) {
+	borders := b.borderStyles
+	if borders == nil {
+		borders = DefaultBorders
+	}
+	style := background.Foreground(b.borderFocusColor).Dim(true)
+	screenWidth, screenHeight := screen.Size()
+	set := func(x, y int, r rune) {
+		if x >= 0 && y >= 0 && x < screenWidth && y < screenHeight {
+			screen.SetContent(x, y, r, nil, style)
+		}
+	}
+	left, top, right, bottom := b.x-1, b.y-1, b.x+b.width, b.y+b.height
+	for x := left + 1; x < right; x++ {
+		set(x, top, borders.Horizontal)
+		set(x, bottom, borders.Horizontal)
+	}
+	for y := top + 1; y < bottom; y++ {
+		set(left, y, borders.Vertical)
+		set(right, y, borders.Vertical)
+	}
+	set(left, top, borders.TopLeft)
+	set(right, top, borders.TopRight)
+	set(left, bottom, borders.BottomLeft)
+	set(right, bottom, borders.BottomRight)
+}
+
+// SetBorderBlinkInterval makes the border flash by hiding and showing it
+// alternately, switching after the given interval. Unlike SetBorderBlinking(),
+// which relies on the terminal's blink attribute, this works on all terminals.
+// As the border only changes when the box is drawn, the application must
+// redraw at least once per interval, see Application.SetTickInterval(). A
+// duration of 0 turns blinking off.
+func (b *Box) SetBorderBlinkInterval(interval time.Duration) *Box {
+	b.borderBlinkInterval = interval
+	b.borderBlinkOff = false
+	b.borderBlinkToggled = time.Now()
+	return b
+}
+
 func (b *Box) SetBorderBlinking(blinking bool) *Box {
 	b.borderBlinking = blinking
 	return b
 }
 
-// SetTitle sets the box's title.
+// SetTitle sets the box's title. It may contain color tags unless
+// SetTitleDynamicColors(false) was called.
 func (b *Box) SetTitle(title string) *Box {
 	b.title = title
 	return b
 }
 
+// SetTitleDynamicColors sets whether color tags (e.g. "[red]") in the title
+// are interpreted, which is the default. If false, the title is printed
+// literally, including any square brackets.
+func (b *Box) SetTitleDynamicColors(dynamic bool) *Box {
+	b.titleDynamicColors = dynamic
+	return b
+}
+
+// displayTitle returns the title as it is passed to Print(), i.e. with color
+// tags escaped if they are not to be interpreted.
+func (b *Box) displayTitle() string {
+	if !b.titleDynamicColors {
+		return Escape(b.title)
+	}
+	return b.title
+}
+
 // SetTitleColor sets the box's title color.
 func (b *Box) SetTitleColor(color tcell.Color) *Box {
 	b.titleColor = color
+	b.themeColors &^= themeTitle
 	return b
 }
 
@@ -516,16 +1976,173 @@ func (b *Box) SetTitleAlign(align int) *Box {
 	return b
 }
 
+// SetBadge sets a short text which is drawn into the top-right corner of the
+// border, next to the title. It is only visible if the box has a border. Set
+// to an empty string to remove the badge.
+func (b *Box) SetBadge(badge string) *Box {
+	b.badge = badge
+	return b
+}
+
+// GetBadge returns the box's badge.
+func (b *Box) GetBadge() string {
+	return b.badge
+}
+
+// SetBadgeColor sets the color of the badge.
+func (b *Box) SetBadgeColor(color tcell.Color) *Box {
+	b.badgeColor = color
+	b.themeColors &^= themeBadge
+	return b
+}
+
+// SetTitleBadgePriority determines which of title and badge keeps its full
+// width when the top border is too narrow for both: TitleBadgePriorityTitle
+// (the default) truncates the badge first, TitleBadgePriorityBadge truncates
+// the title first.
+func (b *Box) SetTitleBadgePriority(priority int) *Box {
+	b.titleBadgePriority = priority
+	return b
+}
+
+// SetTitleExt sets the box's title, its alignment (one of AlignLeft,
+// AlignCenter, or AlignRight), and its color in one call.
+func (b *Box) SetTitleExt(title string, align int, color tcell.Color) *Box {
+	b.title = title
+	b.titleAlign = align
+	b.titleColor = color
+	b.themeColors &^= themeTitle
+	return b
+}
+
 // Draw draws this primitive onto the screen.
 func (b *Box) Draw(screen tcell.Screen) {
 	b.DrawForSubclass(screen, b)
+	b.DrawDisabled(screen)
+}
+
+// SetDisabled sets whether or not the box is disabled. A disabled box ignores
+// key and mouse events and is skipped when the focus is moved with Tab and
+// Shift-Tab in Form, Flex, and Grid containers and by the FocusManager. Its
+// background is drawn with the dim attribute and its border color is darkened
+// (as with SetDimWhenUnfocused()). Button, InputField, Checkbox, and DropDown
+// dim their content, too, see DrawDisabled(). Other subclasses may query
+// IsDisabled() to change their appearance.
+func (b *Box) SetDisabled(disabled bool) *Box {
+	b.disabled = disabled
+	return b
 }
 
-// Draw draws this primitive onto the screen.
-func (b *Box) DrawForSubclass(screen tcell.Screen, p Primitive) {
+// IsDisabled returns whether or not the box is disabled.
+func (b *Box) IsDisabled() bool {
+	return b.disabled
+}
+
+// DrawDisabled dims the box's entire area if the box is disabled. Subclasses
+// call it after drawing their content, typically by deferring it at the
+// beginning of their Draw() function.
+func (b *Box) DrawDisabled(screen tcell.Screen) {
+	if !b.disabled {
+		return
+	}
+	totalWidth, totalHeight := screen.Size()
+	for y := b.y; y < b.y+b.height && y < totalHeight; y++ {
+		if y < 0 {
+			continue
+		}
+		for x := b.x; x < b.x+b.width && x < totalWidth; x++ {
+			if x < 0 {
+				continue
+			}
+			mainc, combc, style, _ := screen.GetContent(x, y)
+			screen.SetContent(x, y, mainc, combc, style.Dim(true))
+		}
+	}
+}
+
+// isDisabled returns whether the given primitive is disabled.
+func isDisabled(p Primitive) bool {
+	d, ok := p.(interface{ IsDisabled() bool })
+	return ok && d.IsDisabled()
+}
+
+// transparentScreen wraps a tcell.Screen and keeps the background color of the
+// cells it writes to. It is used to draw the border of boxes with a
+// transparent background.
+type transparentScreen struct {
+	tcell.Screen
+}
+
+// SetContent sets the content of the cell at the given position, keeping its
+// current background color.
+func (t transparentScreen) SetContent(x, y int, primary rune, combining []rune, style tcell.Style) {
+	_, _, current, _ := t.Screen.GetContent(x, y)
+	_, background, _ := current.Decompose()
+	t.Screen.SetContent(x, y, primary, combining, style.Background(background))
+}
+
+// drawBackgroundPattern tiles the background pattern across the box. Wide
+// runes occupy two cells; if one doesn't fit at the right edge, a space is
+// drawn instead.
+func (b *Box) drawBackgroundPattern(screen tcell.Screen) {
+	style := b.patternStyle.Reverse(b.reverse)
+	for y := b.y; y < b.y+b.height; y++ {
+		index := 0
+		for x := b.x; x < b.x+b.width; x++ {
+			ch := b.backgroundPattern[index%len(b.backgroundPattern)]
+			index++
+			width := runewidth.RuneWidth(ch)
+			if width < 1 {
+				ch, width = ' ', 1
+			}
+			if x+width > b.x+b.width {
+				ch, width = ' ', 1
+			}
+			screen.SetContent(x, y, ch, nil, style)
+			if width > 1 {
+				x++ // Skip the trailing cell of the wide rune.
+			}
+		}
+	}
+}
+
+// DrawForSubclass draws the box's background and border for the given
+// subclass. It returns false if drawing was skipped by the function installed
+// with SetOnBeforeDraw(), in which case the subclass should not draw its
+// contents either.
+//
+// If the inner rect differs from the one of the previous call, an
+// EventInnerRect event with the new position and size is sent to the evented
+// function (see SetEventedFunc()).
+func (b *Box) DrawForSubclass(screen tcell.Screen, p Primitive) bool {
+	// Pick up changes to the theme.
+	b.applyTheme()
+
+	// Resolve a percentage-based rect against the screen size.
+	if b.rectPercent != nil {
+		screenWidth, screenHeight := screen.Size()
+		percent := b.rectPercent
+		setItemRect(p,
+			int(percent[0]*float64(screenWidth)/100),
+			int(percent[1]*float64(screenHeight)/100),
+			int(percent[2]*float64(screenWidth)/100),
+			int(percent[3]*float64(screenHeight)/100),
+		)
+	}
+
+	// Offset the box during a slide transition.
+	if b.slideState != nil {
+		b.applySlide(screen, p)
+	}
+
+	// Let the user skip this frame.
+	if b.beforeDraw != nil && b.beforeDraw(screen) {
+		return false
+	}
+
 	// Don't draw anything if there is no space.
 	if b.width <= 0 || b.height <= 0 || !b.visible {
-		return
+		return true
 	}
 
 	borderVisible := b.borderVisible
@@ -534,16 +2151,38 @@ func (b *Box) DrawForSubclass(screen tcell.Screen, p Primitive) {
 
 	// Fill background.
 	background := def.Background(b.backgroundColor).Reverse(b.reverse)
+	b.dimmed = b.disabled || b.dimUnfocused && !p.HasFocus()
+	b.descendantFocused = b.borderFocusFollowsChildren && !b.hasFocus && p.HasFocus()
+	if b.dimmed {
+		background = background.Dim(true)
+	}
 	if !b.dontClear {
-		for y := b.y; y < b.y+b.height; y++ {
-			for x := b.x; x < b.x+b.width; x++ {
-				screen.SetContent(x, y, ' ', nil, background)
+		if len(b.backgroundPattern) > 0 {
+			b.drawBackgroundPattern(screen)
+		} else if b.gradient {
+			b.drawBackgroundGradient(screen, background)
+		} else if !b.useTerminalBackground || b.backgroundColor != tcell.ColorDefault {
+			for y := b.y; y < b.y+b.height; y++ {
+				for x := b.x; x < b.x+b.width; x++ {
+					screen.SetContent(x, y, ' ', nil, background)
+				}
 			}
 		}
 	}
 
 	// Draw border.
-	b.DrawBorder(borderVisible, background, screen)
+	b.advanceBorderAnimation()
+	if b.dontClear {
+		b.DrawBorder(borderVisible, background, transparentScreen{screen})
+	} else {
+		b.DrawBorder(borderVisible, background, screen)
+	}
+	if b.focusGlow && b.hasFocus {
+		b.drawFocusGlow(screen, background)
+	}
+	if b.shadow {
+		b.drawShadow(screen)
+	}
 
 	// Call custom draw function.
 	if b.draw != nil {
@@ -560,43 +2199,108 @@ func (b *Box) DrawForSubclass(screen tcell.Screen, p Primitive) {
 		b.innerX, b.innerY, b.innerWidth, b.innerHeight = b.GetInnerRect()
 	}
 
+	b.clipped = false
 	if !b.animating {
 		// Clamp inner rect to screen.
-		width, height := screen.Size()
-		if b.innerX < 0 {
-			b.innerWidth += b.innerX
-			b.innerX = 0
-		}
-		if b.innerX+b.innerWidth >= width {
-			b.innerWidth = width - b.innerX
-		}
-		if b.innerY+b.innerHeight >= height {
-			b.innerHeight = height - b.innerY
-		}
-		if b.innerY < 0 {
-			b.innerHeight += b.innerY
-			b.innerY = 0
-		}
+		requestedWidth, requestedHeight := b.innerWidth, b.innerHeight
+		b.innerX, b.innerY, b.innerWidth, b.innerHeight = clampToScreen(screen, b.innerX, b.innerY, b.innerWidth, b.innerHeight)
+		b.clipped = b.innerWidth < requestedWidth || b.innerHeight < requestedHeight
+	}
 
-		if b.innerWidth < 0 {
-			b.innerWidth = 0
-		}
-		if b.innerHeight < 0 {
-			b.innerHeight = 0
-		}
+	// A changed inner rect invalidates cached layouts.
+	if rect := [4]int{b.innerX, b.innerY, b.innerWidth, b.innerHeight}; rect != b.layoutRect {
+		b.layoutRect = rect
+		b.layoutValid = false
+		b.Event(func(f EventedFunc) {
+			f(EventInnerRect, p, rect[0], rect[1], rect[2], rect[3])
+		})
 	}
-	return
+	return true
+}
+
+// clampToScreen returns the intersection of the given rect with the screen.
+// The width and height are 0 if they don't intersect.
+func clampToScreen(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
+	screenWidth, screenHeight := screen.Size()
+	if x < 0 {
+		width += x
+		x = 0
+	}
+	if x+width >= screenWidth {
+		width = screenWidth - x
+	}
+	if y+height >= screenHeight {
+		height = screenHeight - y
+	}
+	if y < 0 {
+		height += y
+		y = 0
+	}
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	return x, y, width, height
+}
+
+// VisibleRect returns the part of the box's rect which lies on the given
+// screen and whether there is any, e.g. to skip generating content for boxes
+// which are scrolled or slid off the screen.
+func (b *Box) VisibleRect(screen tcell.Screen) (x, y, width, height int, visible bool) {
+	x, y, width, height = clampToScreen(screen, b.x, b.y, b.width, b.height)
+	return x, y, width, height, width > 0 && height > 0
+}
+
+// InvalidateLayout marks any layout a subclass has cached for the current
+// inner rect as outdated, e.g. after a setter changed something the layout
+// depends on. Changes to the inner rect itself (due to SetRect(), borders, or
+// padding) are detected automatically when the box is drawn.
+func (b *Box) InvalidateLayout() {
+	b.layoutValid = false
+}
+
+// IsLayoutValid returns whether a layout cached by a subclass is still valid,
+// i.e. whether ValidateLayout() was called since the inner rect last changed
+// and since the last call to InvalidateLayout(). Subclasses with expensive
+// layouts call this after DrawForSubclass() and recompute only if it returns
+// false:
+//
+//	if !t.IsLayoutValid() {
+//		t.computeLayout()
+//		t.ValidateLayout()
+//	}
+func (b *Box) IsLayoutValid() bool {
+	return b.layoutValid
+}
+
+// ValidateLayout marks the layout cached by a subclass as valid for the
+// current inner rect. See IsLayoutValid() for details.
+func (b *Box) ValidateLayout() {
+	b.layoutValid = true
+}
+
+// IsClipped returns true if the box's inner rect was reduced during the last
+// call to Draw() because it extended beyond the screen boundaries. It is reset
+// on every draw.
+func (b *Box) IsClipped() bool {
+	return b.clipped
 }
 
 func (b *Box) DrawBorder(borderVisible bool, background tcell.Style, screen tcell.Screen) bool {
 	// background = tcell.StyleDefault.Background(0)
 	if b.border && b.width >= 2 && b.height >= 1 {
 		var borderStyle tcell.Style
-		if b.hasFocus {
+		focused := b.hasFocus || b.descendantFocused
+		if focused {
 			if b.borderVisible {
 				borderVisible = true
 			}
 			borderStyle = background.Foreground(b.borderFocusColor)
+			if b.borderFocusFade > 0 && b.hasFocus {
+				borderStyle = background.Foreground(blendColors(b.borderColor, b.borderFocusColor, b.borderFade))
+			}
 			IsVtxxx := func() bool {
 				return false
 			}()
@@ -605,11 +2309,44 @@ func (b *Box) DrawBorder(borderVisible bool, background tcell.Style, screen tcel
 			}
 		} else {
 			borderStyle = background.Foreground(b.borderColor)
+			if b.borderFocusFade > 0 {
+				borderStyle = background.Foreground(blendColors(b.borderColor, b.borderFocusColor, b.borderFade))
+			}
 		}
 
 		if b.borderBlinking {
 			borderStyle = borderStyle.Blink(true)
 		}
+		if b.borderBlinkInterval > 0 {
+			if now := time.Now(); now.Sub(b.borderBlinkToggled) >= b.borderBlinkInterval {
+				b.borderBlinkOff = !b.borderBlinkOff
+				b.borderBlinkToggled = now
+			}
+			if b.borderBlinkOff {
+				borderVisible = false
+			}
+		}
+		if b.blurRejected && b.blurRejectedColor != tcell.ColorDefault {
+			borderStyle = borderStyle.Foreground(b.blurRejectedColor).Blink(true)
+		}
+		topStyle, leftStyle, bottomStyle, rightStyle := borderStyle, borderStyle, borderStyle, borderStyle
+		if !focused && !b.blurRejected {
+			sideStyle := func(color tcell.Color) tcell.Style {
+				if color == tcell.ColorDefault {
+					return borderStyle
+				}
+				return borderStyle.Foreground(color)
+			}
+			topStyle, leftStyle = sideStyle(b.borderSideColors[0]), sideStyle(b.borderSideColors[1])
+			bottomStyle, rightStyle = sideStyle(b.borderSideColors[2]), sideStyle(b.borderSideColors[3])
+		}
+		if b.dimmed {
+			dim := func(style tcell.Style) tcell.Style {
+				fg, _, _ := style.Decompose()
+				return style.Foreground(blendColors(fg, tcell.ColorBlack, 0.5))
+			}
+			topStyle, leftStyle, bottomStyle, rightStyle = dim(topStyle), dim(leftStyle), dim(bottomStyle), dim(rightStyle)
+		}
 
 		vertical, horizontal, topLeft, topRight, bottomLeft, bottomRight := ' ', ' ', ' ', ' ', ' ', ' '
 		leftVertical, topHorizontal, rightVertical, bottomHorizontal := ' ', ' ', ' ', ' '
@@ -631,109 +2368,154 @@ func (b *Box) DrawBorder(borderVisible bool, background tcell.Style, screen tcel
 			rightVertical = ifc(b.borderStyles.RightVertical, vertical)
 			topHorizontal = ifc(b.borderStyles.TopHorizontal, horizontal)
 			bottomHorizontal = ifc(b.borderStyles.BottomHorizontal, horizontal)
-
-		} else {
-		}
-
-		if b.borderTop {
-			for x := b.x + 1; x < b.x+b.width-1; x++ {
-				screen.SetContent(x, b.y, topHorizontal, nil, borderStyle)
-			}
-
-			if b.borderLeft {
-				screen.SetContent(b.x, b.y, topLeft, nil, borderStyle)
-			} else {
-				screen.SetContent(b.x, b.y, topHorizontal, nil, borderStyle)
+			for corner, r := range b.borderCorners {
+				switch corner {
+				case BorderCornerTopLeft:
+					topLeft = r
+				case BorderCornerTopRight:
+					topRight = r
+				case BorderCornerBottomLeft:
+					bottomLeft = r
+				case BorderCornerBottomRight:
+					bottomRight = r
+				}
 			}
 
-			if b.borderRight {
-				screen.SetContent(b.x+b.width-1, b.y, topRight, nil, borderStyle)
-			} else {
-				screen.SetContent(b.x+b.width-1, b.y, topHorizontal, nil, borderStyle)
-			}
+		} else {
 		}
 
-		if b.height > 1 {
-			if b.borderBottom {
-				for x := b.x + 1; x < b.x+b.width-1; x++ {
-					screen.SetContent(x, b.y+b.height-1, bottomHorizontal, nil, borderStyle)
+		// Draw the rings from the outside in.
+		ring := func(x, y, width, height int) {
+			if b.borderTop {
+				for column := x + 1; column < x+width-1; column++ {
+					screen.SetContent(column, y, topHorizontal, nil, topStyle)
 				}
 
 				if b.borderLeft {
-					screen.SetContent(b.x, b.y+b.height-1, bottomLeft, nil, borderStyle)
+					screen.SetContent(x, y, topLeft, nil, topStyle)
 				} else {
-					screen.SetContent(b.x, b.y+b.height-1, bottomHorizontal, nil, borderStyle)
+					screen.SetContent(x, y, topHorizontal, nil, topStyle)
 				}
+
 				if b.borderRight {
-					screen.SetContent(
-						b.x+b.width-1,
-						b.y+b.height-1,
-						bottomRight,
-						nil,
-						borderStyle,
-					)
+					screen.SetContent(x+width-1, y, topRight, nil, topStyle)
 				} else {
-					screen.SetContent(b.x+b.width-1, b.y+b.height-1, bottomHorizontal, nil, borderStyle)
+					screen.SetContent(x+width-1, y, topHorizontal, nil, topStyle)
 				}
 			}
 
-			if b.borderLeft {
-				for y := b.y + 1; y < b.y+b.height-1; y++ {
-					screen.SetContent(b.x, y, leftVertical, nil, borderStyle)
-				}
-
-				if b.borderTop {
-					screen.SetContent(b.x, b.y, topLeft, nil, borderStyle)
-				} else {
-					screen.SetContent(b.x, b.y, leftVertical, nil, borderStyle)
-				}
-
+			if height > 1 {
 				if b.borderBottom {
-					screen.SetContent(b.x, b.y+b.height-1, bottomLeft, nil, borderStyle)
-				} else {
-					screen.SetContent(b.x, b.y+b.height-1, leftVertical, nil, borderStyle)
+					for column := x + 1; column < x+width-1; column++ {
+						screen.SetContent(column, y+height-1, bottomHorizontal, nil, bottomStyle)
+					}
+
+					if b.borderLeft {
+						screen.SetContent(x, y+height-1, bottomLeft, nil, bottomStyle)
+					} else {
+						screen.SetContent(x, y+height-1, bottomHorizontal, nil, bottomStyle)
+					}
+					if b.borderRight {
+						screen.SetContent(
+							x+width-1,
+							y+height-1,
+							bottomRight,
+							nil,
+							bottomStyle,
+						)
+					} else {
+						screen.SetContent(x+width-1, y+height-1, bottomHorizontal, nil, bottomStyle)
+					}
 				}
-			}
 
-			if b.borderRight {
-				for y := b.y + 1; y < b.y+b.height-1; y++ {
-					screen.SetContent(b.x+b.width-1, y, rightVertical, nil, borderStyle)
+				if b.borderLeft {
+					for row := y + 1; row < y+height-1; row++ {
+						screen.SetContent(x, row, leftVertical, nil, leftStyle)
+					}
+
+					if b.borderTop {
+						screen.SetContent(x, y, topLeft, nil, topStyle)
+					} else {
+						screen.SetContent(x, y, leftVertical, nil, leftStyle)
+					}
+
+					if b.borderBottom {
+						screen.SetContent(x, y+height-1, bottomLeft, nil, bottomStyle)
+					} else {
+						screen.SetContent(x, y+height-1, leftVertical, nil, leftStyle)
+					}
 				}
 
-				if b.borderTop {
-					screen.SetContent(b.x+b.width-1, b.y, topRight, nil, borderStyle)
-				} else {
-					screen.SetContent(b.x+b.width-1, b.y, rightVertical, nil, borderStyle)
+				if b.borderRight {
+					for row := y + 1; row < y+height-1; row++ {
+						screen.SetContent(x+width-1, row, rightVertical, nil, rightStyle)
+					}
+
+					if b.borderTop {
+						screen.SetContent(x+width-1, y, topRight, nil, topStyle)
+					} else {
+						screen.SetContent(x+width-1, y, rightVertical, nil, rightStyle)
+					}
+
+					if b.borderBottom {
+						screen.SetContent(
+							x+width-1,
+							y+height-1,
+							bottomRight,
+							nil,
+							bottomStyle,
+						)
+					} else {
+						screen.SetContent(x+width-1, y+height-1, rightVertical, nil, rightStyle)
+					}
 				}
-
-				if b.borderBottom {
-					screen.SetContent(
-						b.x+b.width-1,
-						b.y+b.height-1,
-						bottomRight,
-						nil,
-						borderStyle,
-					)
-				} else {
-					screen.SetContent(b.x+b.width-1, b.y+b.height-1, rightVertical, nil, borderStyle)
+			} else if height == 1 && !b.borderTop && !b.borderBottom {
+				if b.borderLeft {
+					screen.SetContent(x, y, leftVertical, nil, leftStyle)
+				}
+				if b.borderRight {
+					screen.SetContent(x+width-1, y+height-1, rightVertical, nil, rightStyle)
 				}
 			}
-		} else if b.height == 1 && !b.borderTop && !b.borderBottom {
-			if b.borderLeft {
-				screen.SetContent(b.x, b.y, leftVertical, nil, borderStyle)
-			}
-			if b.borderRight {
-				screen.SetContent(b.x+b.width-1, b.y+b.height-1, rightVertical, nil, borderStyle)
+		}
+		x, y, width, height := b.x, b.y, b.width, b.height
+		for thickness := 0; thickness < b.borderThickness && width >= 2 && height >= 1; thickness++ {
+			ring(x, y, width, height)
+			top, bottom, left, right := boolToInt(b.borderTop), boolToInt(b.borderBottom), boolToInt(b.borderLeft), boolToInt(b.borderRight)
+			x, y, width, height = x+left, y+top, width-left-right, height-top-bottom
+		}
+		if b.doubleFrame && width >= 2 && height >= 1 {
+			if b.innerFrameColor != tcell.ColorDefault {
+				innerStyle := borderStyle.Foreground(b.innerFrameColor)
+				if b.dimmed {
+					innerStyle = innerStyle.Foreground(blendColors(b.innerFrameColor, tcell.ColorBlack, 0.5))
+				}
+				topStyle, leftStyle, bottomStyle, rightStyle = innerStyle, innerStyle, innerStyle, innerStyle
 			}
+			ring(x, y, width, height)
+		}
+
+		// Distribute the top border between title and badge.
+		titleWidth, titleSpace, badgeWidth := b.topBorderLayout()
+		if badgeWidth > 0 && b.width >= 4 {
+			Print(screen, b.badge, b.x+1+b.width-2-badgeWidth, b.y, badgeWidth, AlignLeft, b.badgeColor)
+		}
+
+		if b.subtitle != "" && b.borderBottom && b.width >= 4 && b.height >= 2 {
+			Print(screen, b.subtitle, b.x+1, b.y+b.height-1, b.width-2, b.subtitleAlign, b.subtitleColor)
 		}
 
-		if b.title != "" && b.width >= 4 {
+		if len(b.titleTabs) > 0 {
+			b.drawTitleTabs(screen)
+		} else if lines, truncated := b.titleLines(); len(lines) > 1 || truncated {
+			b.drawTitleLines(screen, lines, truncated, titleSpace)
+		} else if b.title != "" && b.width >= 4 && titleWidth > 0 {
 			_, _ = Print(
 				screen,
-				b.title,
-				b.x+1,
+				b.displayTitle(),
+				b.x+1+b.titlePaddingLeft,
 				b.y,
-				b.width-2,
+				titleSpace,
 				b.titleAlign,
 				b.titleColor,
 			)
@@ -755,20 +2537,383 @@ func (b *Box) DrawBorder(borderVisible bool, background tcell.Style, screen tcel
 	return false
 }
 
-// Focus is called when this primitive receives focus.
+// SetSubtitle sets a text drawn into the bottom border, e.g. key hints such as
+// "ESC: cancel  ENTER: ok". It is only visible if the box has a bottom border.
+// The subtitle may contain color tags.
+func (b *Box) SetSubtitle(subtitle string) *Box {
+	b.subtitle = subtitle
+	return b
+}
+
+// GetSubtitle returns the box's subtitle.
+func (b *Box) GetSubtitle() string {
+	return b.subtitle
+}
+
+// SetSubtitleColor sets the color of the subtitle.
+func (b *Box) SetSubtitleColor(color tcell.Color) *Box {
+	b.subtitleColor = color
+	b.themeColors &^= themeSubtitle
+	return b
+}
+
+// SetSubtitleAlign sets the alignment of the subtitle, one of AlignLeft,
+// AlignCenter (the default), or AlignRight.
+func (b *Box) SetSubtitleAlign(align int) *Box {
+	b.subtitleAlign = align
+	return b
+}
+
+// AnimateBorderStyle morphs the border from one glyph set to another over the
+// given number of redraws, e.g. from rounded to square corners when the box
+// receives focus. Glyphs are not interpolated but swapped: the first third of
+// the frames shows the "from" style, the second third the "to" style's corners
+// with the "from" style's edges, and the last third the "to" style. When the
+// animation ends, the "to" style is kept as the box's border style (see
+// SetBorderStyle()).
+//
+// Frames advance only when the box is drawn. The perFrame function, which may
+// be nil, is called after each frame except the last one and should schedule
+// the next redraw, e.g. with Application.QueueUpdateDraw(). The box is marked
+// as animating (see GetAnimating()) while the animation runs. Starting a new
+// animation replaces a running one. If frames is less than 1, the "to" style
+// is applied immediately.
+func (b *Box) AnimateBorderStyle(from, to *BorderStyle, frames int, perFrame func()) *Box {
+	if frames < 1 || from == nil || to == nil {
+		b.borderAnimation = nil
+		b.SetAnimating(false)
+		if to != nil {
+			b.borderStyles = to
+		}
+		return b
+	}
+	b.borderAnimation = &borderAnimation{from: from, to: to, frames: frames, perFrame: perFrame}
+	b.borderStyles = from
+	b.SetAnimating(true)
+	return b
+}
+
+// advanceBorderAnimation sets the border style for the next frame of the
+// border style animation, if there is one, and ends the animation after its
+// last frame.
+func (b *Box) advanceBorderAnimation() {
+	animation := b.borderAnimation
+	if animation == nil {
+		return
+	}
+	animation.frame++
+	switch progress := float64(animation.frame) / float64(animation.frames); {
+	case progress >= 1:
+		b.borderStyles = animation.to
+		b.borderAnimation = nil
+		b.SetAnimating(false)
+		return
+	case progress*3 >= 2:
+		b.borderStyles = animation.to
+	case progress*3 >= 1:
+		mixed := *animation.from
+		mixed.TopLeft, mixed.TopRight = animation.to.TopLeft, animation.to.TopRight
+		mixed.BottomLeft, mixed.BottomRight = animation.to.BottomLeft, animation.to.BottomRight
+		mixed.TopLeftFocus, mixed.TopRightFocus = animation.to.TopLeftFocus, animation.to.TopRightFocus
+		mixed.BottomLeftFocus, mixed.BottomRightFocus = animation.to.BottomLeftFocus, animation.to.BottomRightFocus
+		b.borderStyles = &mixed
+	default:
+		b.borderStyles = animation.from
+	}
+	if animation.perFrame != nil {
+		animation.perFrame()
+	}
+}
+
+// SetTitlePadding sets the number of cells kept free between the border's
+// corners (or the badge) and the title, on the left and on the right. The
+// title is truncated if there is not enough room left for it. Negative values
+// are treated as 0.
+func (b *Box) SetTitlePadding(left, right int) *Box {
+	if left < 0 {
+		left = 0
+	}
+	if right < 0 {
+		right = 0
+	}
+	b.titlePaddingLeft, b.titlePaddingRight = left, right
+	return b
+}
+
+// SetTitleWrap sets whether a title which doesn't fit into the top border is
+// wrapped onto additional rows below it. These rows are added to the top
+// border, i.e. the inner rect (see GetInnerRect()) shrinks accordingly. If the
+// box is too small for all rows, the title is truncated with an ellipsis.
+func (b *Box) SetTitleWrap(wrap bool) *Box {
+	b.titleWrap = wrap
+	return b
+}
+
+// titleLines returns the lines of a wrapped title (see SetTitleWrap()) and
+// whether lines were omitted because they don't fit into the box. It returns
+// nil if the title is not wrapped.
+func (b *Box) titleLines() (lines []string, truncated bool) {
+	if !b.titleWrap || !b.border || b.title == "" || len(b.titleTabs) > 0 || b.width < 4 {
+		return nil, false
+	}
+	_, titleSpace, _ := b.topBorderLayout()
+	title := b.displayTitle()
+	if titleSpace <= 0 || TaggedStringWidth(title) <= titleSpace {
+		return nil, false
+	}
+	lines = WordWrap(title, titleSpace)
+	maxLines := b.height - (b.borderThickness+boolToInt(b.doubleFrame))*boolToInt(b.borderBottom)
+	if maxLines < 1 {
+		maxLines = 1
+	}
+	if len(lines) > maxLines {
+		lines, truncated = lines[:maxLines], true
+	}
+	return
+}
+
+// drawTitleLines draws the lines of a wrapped title, the first one into the
+// top border and the others below it. If truncated is true, the last line
+// ends with an ellipsis.
+func (b *Box) drawTitleLines(screen tcell.Screen, lines []string, truncated bool, titleSpace int) {
+	top, _, left, right := b.borderWidths()
+	top -= len(lines) - 1
+	for index, line := range lines {
+		x, y, width := b.x+1+b.titlePaddingLeft, b.y, titleSpace
+		if index > 0 {
+			x, y, width = b.x+left+b.titlePaddingLeft, b.y+top+index-1, b.width-left-right
+			if width > titleSpace {
+				width = titleSpace
+			}
+		}
+		if !truncated || index < len(lines)-1 {
+			Print(screen, line, x, y, width, b.titleAlign, b.titleColor)
+			continue
+		}
+
+		// Reserve the last cell for the ellipsis.
+		lineWidth := TaggedStringWidth(line)
+		if lineWidth > width-1 {
+			lineWidth = width - 1
+		}
+		switch b.titleAlign {
+		case AlignCenter:
+			x += (width - lineWidth - 1) / 2
+		case AlignRight:
+			x += width - lineWidth - 1
+		}
+		_, printed := Print(screen, line, x, y, lineWidth, AlignLeft, b.titleColor)
+		Print(screen, string(SemigraphicsHorizontalEllipsis), x+printed, y, 1, AlignLeft, b.titleColor)
+	}
+}
+
+// topBorderLayout distributes the top border between title and badge. It
+// returns the title's width, the space available for the title, and the
+// badge's width.
+func (b *Box) topBorderLayout() (titleWidth, titleSpace, badgeWidth int) {
+	available := b.width - 2
+	if b.title != "" {
+		titleWidth = TaggedStringWidth(b.displayTitle())
+	}
+	if b.badge != "" {
+		badgeWidth = TaggedStringWidth(b.badge)
+	}
+	if titleWidth > 0 && badgeWidth > 0 && titleWidth+1+badgeWidth > available {
+		if b.titleBadgePriority == TitleBadgePriorityBadge {
+			badgeWidth = int(math.Min(float64(badgeWidth), float64(available)))
+			titleWidth = available - badgeWidth - 1
+		} else {
+			titleWidth = int(math.Min(float64(titleWidth), float64(available)))
+			badgeWidth = available - titleWidth - 1
+		}
+	}
+	titleSpace = available
+	if badgeWidth > 0 && b.width >= 4 {
+		titleSpace = available - badgeWidth - 1
+	}
+	titleSpace -= b.titlePaddingLeft + b.titlePaddingRight
+	if titleSpace < 0 {
+		titleSpace = 0
+	}
+	return
+}
+
+// SetTitleTabs draws the given tabs into the top border instead of the title,
+// left to right, with the tab at the given index highlighted. The tabs may
+// contain color tags. Tabs which don't fit are truncated or omitted. Provide
+// no tabs to show the title again.
+func (b *Box) SetTitleTabs(tabs []string, active int) *Box {
+	b.titleTabs = append([]string(nil), tabs...)
+	b.titleTabActive = active
+	return b
+}
+
+// GetTitleTabs returns the tabs set with SetTitleTabs() and the index of the
+// highlighted one.
+func (b *Box) GetTitleTabs() (tabs []string, active int) {
+	return append([]string(nil), b.titleTabs...), b.titleTabActive
+}
+
+// SetTitleTabClickHandler sets a function which is called with the index of a
+// title tab (see SetTitleTabs()) when it is clicked. The clicked tab is
+// highlighted before the function is called.
+func (b *Box) SetTitleTabClickHandler(handler func(index int)) *Box {
+	b.titleTabClick = handler
+	return b
+}
+
+// titleTabSpans returns the screen columns at which the title tabs are drawn
+// and their drawn widths. Tabs which don't fit are omitted.
+func (b *Box) titleTabSpans() (xs, widths []int) {
+	if !b.border || !b.borderTop || len(b.titleTabs) == 0 || b.width < 4 || b.height < 1 {
+		return nil, nil
+	}
+	available := b.width - 2 - b.titlePaddingLeft - b.titlePaddingRight
+	if b.badge != "" {
+		available -= TaggedStringWidth(b.badge) + 1
+	}
+	x := b.x + 1 + b.titlePaddingLeft
+	for _, tab := range b.titleTabs {
+		width := TaggedStringWidth(tab) + 2
+		if width > available {
+			width = available
+		}
+		if width <= 0 {
+			break
+		}
+		xs, widths = append(xs, x), append(widths, width)
+		x += width + 1 // One border cell between tabs.
+		available -= width + 1
+	}
+	return
+}
+
+// titleTabAt returns the index of the title tab at the given screen position
+// or -1 if there is none.
+func (b *Box) titleTabAt(x, y int) int {
+	if y != b.y {
+		return -1
+	}
+	xs, widths := b.titleTabSpans()
+	for index := range xs {
+		if x >= xs[index] && x < xs[index]+widths[index] {
+			return index
+		}
+	}
+	return -1
+}
+
+// drawTitleTabs draws the title tabs into the top border.
+func (b *Box) drawTitleTabs(screen tcell.Screen) {
+	xs, widths := b.titleTabSpans()
+	for index := range xs {
+		style := tcell.StyleDefault.Foreground(b.titleColor)
+		if index == b.titleTabActive {
+			style = style.Reverse(true)
+		}
+		printWithStyle(screen, " "+b.titleTabs[index]+" ", xs[index], b.y, 0, widths[index], AlignLeft, style, true)
+	}
+}
+
+// titleSpan returns the screen column at which the title is drawn and its
+// drawn width. The width is 0 if no title is drawn.
+func (b *Box) titleSpan() (x, width int) {
+	if !b.border || b.title == "" || len(b.titleTabs) > 0 || b.width < 4 || b.height < 1 {
+		return 0, 0
+	}
+	titleWidth, titleSpace, _ := b.topBorderLayout()
+	if titleWidth <= 0 || titleSpace <= 0 {
+		return 0, 0
+	}
+	width = TaggedStringWidth(b.displayTitle())
+	if width > titleSpace {
+		width = titleSpace
+	}
+	x = b.x + 1 + b.titlePaddingLeft
+	switch b.titleAlign {
+	case AlignCenter:
+		x += (titleSpace - width) / 2
+	case AlignRight:
+		x += titleSpace - width
+	}
+	return
+}
+
+// SetOnTitleClick sets a handler which is called when the user clicks on the
+// title in the top border, e.g. to collapse or expand the box's content.
+// Clicks elsewhere on the border are not affected. Title clicks are consumed
+// and not passed on to the primitive's mouse handler.
+func (b *Box) SetOnTitleClick(handler func()) *Box {
+	b.onTitleClick = handler
+	return b
+}
+
+// Focus is called when this primitive receives focus. It calls the handler set
+// with SetOnFocus() and sends an EventFocus event to the evented function (see
+// SetEventedFunc()).
 func (b *Box) Focus(delegate func(p Primitive)) {
 	b.hasFocus = true
+	b.fadeBorder(true)
 	if b.onFocus != nil {
 		b.onFocus()
 	}
+	b.Event(func(f EventedFunc) {
+		f(EventFocus, b)
+	})
+}
+
+// BlurValidator is implemented by primitives which may refuse to lose focus.
+// Before the application moves focus away from a primitive implementing it,
+// CanBlur() is called and the focus change is cancelled if it returns false.
+type BlurValidator interface {
+	CanBlur() bool
+}
+
+// SetBlurValidator sets a function which is consulted before focus moves away
+// from this box. If it returns false, e.g. because the box's content is
+// invalid, the box keeps focus. This applies to all focus changes going
+// through Application.SetFocus(), including programmatic ones and those
+// triggered by Tab navigation in containers, so make sure the user can always
+// make the content valid (or remove the validator with nil) to avoid trapping
+// focus. Blur() itself is not affected. As the validator is only consulted
+// for the primitive which actually has focus, it has no effect on containers
+// which hand focus on to their children.
+//
+// See also SetBlurRejectedColor().
+func (b *Box) SetBlurValidator(validator func() bool) *Box {
+	b.blurValidator = validator
+	b.blurRejected = false
+	return b
+}
+
+// SetBlurRejectedColor sets the color in which the border blinks after the
+// blur validator has rejected a focus change, until it accepts one. The
+// default, tcell.ColorDefault, leaves the border unchanged.
+func (b *Box) SetBlurRejectedColor(color tcell.Color) *Box {
+	b.blurRejectedColor = color
+	return b
+}
+
+// CanBlur returns whether this box may lose focus, as determined by the
+// function installed with SetBlurValidator(). It returns true if there is no
+// such function.
+func (b *Box) CanBlur() bool {
+	b.blurRejected = b.blurValidator != nil && !b.blurValidator()
+	return !b.blurRejected
 }
 
-// Blur is called when this primitive loses focus.
+// Blur is called when this primitive loses focus. It calls the handler set
+// with SetOnBlur() and sends an EventBlur event to the evented function (see
+// SetEventedFunc()).
 func (b *Box) Blur() {
 	b.hasFocus = false
+	b.fadeBorder(false)
 	if b.onBlur != nil {
 		b.onBlur()
 	}
+	b.Event(func(f EventedFunc) {
+		f(EventBlur, b)
+	})
 }
 
 // SetNextFocusableComponents decides which components are to be focused using
@@ -783,7 +2928,9 @@ func (b *Box) SetNextFocusableComponents(
 }
 
 // NextFocusableComponent decides which component should receive focus next.
-// If nil is returned, the focus is retained.
+// If nil is returned, the focus is retained. If none of the components
+// registered for the direction is visible, the first one is returned if focus
+// wrapping is enabled (see SetFocusWrap()) and nil otherwise.
 func (b *Box) NextFocusableComponent(direction FocusDirection) Primitive {
 	components, avail := b.nextFocusableComponents[direction]
 	if avail {
@@ -792,11 +2939,22 @@ func (b *Box) NextFocusableComponent(direction FocusDirection) Primitive {
 				return comp
 			}
 		}
+		if b.focusWrap && len(components) > 0 {
+			return components[0]
+		}
 	}
 
 	return nil
 }
 
+// SetFocusWrap sets whether NextFocusableComponent() returns the first
+// component registered for a direction when none of them is visible, instead
+// of retaining the focus. This allows circular navigation, e.g. in a toolbar.
+func (b *Box) SetFocusWrap(wrap bool) *Box {
+	b.focusWrap = wrap
+	return b
+}
+
 func (b *Box) GetAnimating() bool {
 	return b.animating
 }
@@ -823,17 +2981,88 @@ func (b *Box) SetIndicateOverflow(indicateOverflow bool) *Box {
 	return b
 }
 
+// SetOverflowVisibility determines when the overflow indicator is drawn:
+// OverflowAlways (the default) draws it regardless of the content, OverflowAuto
+// draws it only if content was cut off at the top or the bottom (as reported
+// by the subclass to DrawOverflow()), and OverflowNever turns it off. All modes
+// but OverflowNever enable the indicator (see SetIndicateOverflow()).
+func (b *Box) SetOverflowVisibility(mode int) *Box {
+	b.overflowVisibility = mode
+	b.indicateOverflow = mode != OverflowNever
+	return b
+}
+
 // SetParent defines which component this primitive is currently being
-// treated as a child of. This should never be called manually.
+// treated as a child of. This should never be called manually. Flex and Grid
+// call it for the items added to them.
 func (b *Box) SetParent(parent Primitive) {
 	// Reparenting is possible!
 	b.parent = parent
 }
 
+// SetOverflowSide sets the side of the inner rect on which the overflow
+// indicator is drawn, OverflowSideRight (the default) or OverflowSideLeft,
+// e.g. for right-to-left layouts or panels docked on the left. A left-side
+// indicator is not drawn if the inner rect starts at the left edge of the
+// screen.
+func (b *Box) SetOverflowSide(side int) *Box {
+	b.overflowSide = side
+	return b
+}
+
+// SetOverflowScrollHandler sets a handler which is called when the user clicks
+// on the overflow indicator drawn by DrawOverflow(). It receives the clicked
+// position as a fraction of the indicator's height, from 0 (top) to 1
+// (bottom), so that subclasses can scroll to the respective part of their
+// content. Such clicks are consumed and not passed on to the primitive's mouse
+// handler.
+func (b *Box) SetOverflowScrollHandler(handler func(fraction float64)) *Box {
+	b.overflowScroll = handler
+	return b
+}
+
+// overflowFraction returns the position of the given screen coordinates on the
+// overflow indicator as a fraction of its height and whether the coordinates
+// are on the indicator at all.
+func (b *Box) overflowFraction(x, y int) (fraction float64, ok bool) {
+	if !b.indicateOverflow || b.overflowVisibility == OverflowNever || b.innerX < 0 || b.innerHeight < 1 {
+		return 0, false
+	}
+	indicatorX, visible := b.overflowIndicatorX()
+	if !visible || x != indicatorX || y < b.innerY || y >= b.innerY+b.innerHeight {
+		return 0, false
+	}
+	if b.innerHeight == 1 {
+		return 0, true
+	}
+	return float64(y-b.innerY) / float64(b.innerHeight-1), true
+}
+
+// overflowIndicatorX returns the screen column of the overflow indicator and
+// whether there is room for it.
+func (b *Box) overflowIndicatorX() (x int, ok bool) {
+	if b.overflowSide == OverflowSideLeft {
+		return b.innerX - 1, b.innerX > 0
+	}
+	return b.innerX + b.innerWidth, true
+}
+
+// DrawOverflow draws the overflow indicator next to the inner rect (see
+// SetOverflowSide()) if it is enabled. Subclasses report whether content was
+// cut off at the top and at the bottom and, optionally, the scroll position as
+// a fraction (or percentage) of the content.
 func (b *Box) DrawOverflow(screen tcell.Screen, showTop, showBottom bool, pct ...float64) {
-	if b.indicateOverflow && b.height > 1 {
-		overflowIndicatorX := b.innerX + b.innerWidth // - (b.paddingRight)
-		style := tcell.StyleDefault.Foreground(Styles.InverseTextColor).
+	switch b.overflowVisibility {
+	case OverflowNever:
+		return
+	case OverflowAuto:
+		if !showTop && !showBottom {
+			return
+		}
+	}
+	overflowIndicatorX, ok := b.overflowIndicatorX()
+	if b.indicateOverflow && b.height > 1 && ok {
+		style := tcell.StyleDefault.Foreground(b.GetTheme().InverseTextColor).
 			Background(tcell.GetColor("#202020"))
 		bgStyle := tcell.StyleDefault.Background(tcell.GetColor("#202020"))
 		topStyle := style
@@ -906,6 +3135,62 @@ func (b *Box) DrawOverflow(screen tcell.Screen, showTop, showBottom bool, pct ..
 	}
 }
 
+// DrawHorizontalOverflow draws a horizontal overflow indicator into the bottom
+// row of the inner rect if the overflow indicator is enabled (see
+// SetIndicateOverflow() and SetOverflowVisibility()). Subclasses with
+// horizontally scrolling content, which should leave that row free, report
+// whether content was cut off on the left and on the right and, optionally,
+// the horizontal scroll position as a fraction (or percentage) of the content.
+func (b *Box) DrawHorizontalOverflow(screen tcell.Screen, showLeft, showRight bool, pct ...float64) {
+	switch b.overflowVisibility {
+	case OverflowNever:
+		return
+	case OverflowAuto:
+		if !showLeft && !showRight {
+			return
+		}
+	}
+	if !b.indicateOverflow || b.innerWidth <= 1 || b.innerHeight < 1 {
+		return
+	}
+	y := b.innerY + b.innerHeight - 1
+	style := tcell.StyleDefault.Foreground(b.GetTheme().InverseTextColor).
+		Background(tcell.GetColor("#202020"))
+	bgStyle := tcell.StyleDefault.Background(tcell.GetColor("#202020"))
+	leftStyle, rightStyle := style, style
+	if !showLeft {
+		leftStyle = style.Foreground(tcell.GetColor("#404040"))
+	}
+	if !showRight {
+		rightStyle = style.Foreground(tcell.GetColor("#404040"))
+	}
+
+	// Same thumb position math as DrawOverflow(), along the row.
+	pcent := 0.0
+	if len(pct) > 0 {
+		pcent = pct[0]
+	}
+	pos := 0.0
+	stp := float64(b.innerWidth-1) / 100.0
+	if pcent != 0.0 {
+		if pcent < 1.0 && pcent > 0.0 {
+			pos = pcent * 100.0 * stp
+		} else if pcent > 1.0 {
+			pos = pcent * stp
+		}
+		pos = math.Ceil(pos)
+	}
+	for i := 1; i < b.innerWidth-1; i++ {
+		cellStyle := bgStyle
+		if pos != 0.0 && math.Abs(float64(int(pos-float64(i)))) <= 1 {
+			cellStyle = bgStyle.Background(tcell.GetColor("#505050"))
+		}
+		screen.SetContent(b.innerX+i, y, ' ', nil, cellStyle)
+	}
+	screen.SetContent(b.innerX, y, '🭪', nil, leftStyle.Reverse(true))
+	screen.SetContent(b.innerX+b.innerWidth-1, y, '🭨', nil, rightStyle.Reverse(true))
+}
+
 // GetParent returns the current parent or nil if the parent hasn't been
 // set yet.
 func (b *Box) GetParent() Primitive {
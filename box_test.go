@@ -0,0 +1,169 @@
+package tview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// TestBoxFocusManager checks that the focus manager set with
+// SetFocusManager() is returned by GetFocusManager().
+func TestBoxFocusManager(t *testing.T) {
+	box := NewBox()
+	if fm := box.GetFocusManager(); fm != nil {
+		t.Fatalf("new box has focus manager %p, expected none", fm)
+	}
+
+	manager := NewFocusManager(func(p Primitive) {})
+	if result := box.SetFocusManager(manager); result != box {
+		t.Errorf("SetFocusManager() returned %p, expected the box %p", result, box)
+	}
+	if fm := box.GetFocusManager(); fm != manager {
+		t.Errorf("GetFocusManager() returned %p, expected %p", fm, manager)
+	}
+
+	box.SetFocusManager(nil)
+	if fm := box.GetFocusManager(); fm != nil {
+		t.Errorf("GetFocusManager() returned %p after removing the manager, expected nil", fm)
+	}
+}
+
+// TestBoxSetMouseHandler checks that MouseHandler() calls the handler set with
+// SetMouseHandler() for events inside the box, after the mouse capture
+// function, and falls back to the default handler if it doesn't consume the
+// event.
+func TestBoxSetMouseHandler(t *testing.T) {
+	box := NewBox()
+	box.SetRect(0, 0, 10, 5)
+
+	var received *tcell.EventMouse
+	consume := true
+	box.SetMouseHandler(func(event *tcell.EventMouse) bool {
+		received = event
+		return consume
+	})
+	focused := false
+	setFocus := func(p Primitive) {
+		focused = true
+	}
+	handle := func(x, y int) bool {
+		received, focused = nil, false
+		consumed, _ := box.MouseHandler()(MouseLeftClick, tcell.NewEventMouse(x, y, tcell.Button1, 0), setFocus)
+		return consumed
+	}
+
+	// The custom handler consumes the event.
+	if consumed := handle(2, 2); !consumed || received == nil || focused {
+		t.Errorf("consuming handler: consumed %v, handler called %v, default handler called %v", consumed, received != nil, focused)
+	}
+
+	// The default handler runs if the custom handler doesn't consume the event.
+	consume = false
+	if consumed := handle(2, 2); !consumed || received == nil || !focused {
+		t.Errorf("non-consuming handler: consumed %v, handler called %v, default handler called %v", consumed, received != nil, focused)
+	}
+
+	// Events outside the box are not passed on.
+	consume = true
+	if consumed := handle(20, 2); consumed || received != nil || focused {
+		t.Errorf("outside the box: consumed %v, handler called %v, default handler called %v", consumed, received != nil, focused)
+	}
+
+	// The handler receives the event returned by the mouse capture function.
+	moved := tcell.NewEventMouse(3, 4, tcell.Button1, 0)
+	box.SetMouseCapture(func(action MouseAction, event *tcell.EventMouse) (MouseAction, *tcell.EventMouse) {
+		return action, moved
+	})
+	if consumed := handle(20, 2); !consumed || received != moved {
+		t.Errorf("captured event: consumed %v, handler received %v, expected %v", consumed, received, moved)
+	}
+
+	// Events swallowed by the mouse capture function don't reach the handler.
+	box.SetMouseCapture(func(action MouseAction, event *tcell.EventMouse) (MouseAction, *tcell.EventMouse) {
+		return action, nil
+	})
+	if consumed := handle(2, 2); consumed || received != nil || focused {
+		t.Errorf("swallowed event: consumed %v, handler called %v, default handler called %v", consumed, received != nil, focused)
+	}
+}
+
+// TestBoxAnimateWithoutApplication checks that animations skip to their end
+// when no application runs their frames.
+func TestBoxAnimateWithoutApplication(t *testing.T) {
+	box := NewBox()
+	var frames []float64
+	done := false
+	box.Animate(time.Second, nil, func(progress float64) {
+		frames = append(frames, progress)
+	}, func() {
+		done = true
+	})
+	if len(frames) != 1 || frames[0] != 1 || !done {
+		t.Errorf("frames %v, done %v, expected a single frame at 1 and done", frames, done)
+	}
+	if box.GetAnimating() {
+		t.Error("box is marked as animating")
+	}
+}
+
+// TestBoxManagedSize checks that the size setters don't change the rect of a
+// box managed by a layout and that its minimum size applies when the layout
+// is drawn.
+func TestBoxManagedSize(t *testing.T) {
+	box := NewBox()
+	flex := NewFlex().
+		AddItem(box, 5, 0, false).
+		AddItem(NewBox(), 0, 1, false)
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		t.Fatal(err)
+	}
+	defer screen.Fini()
+	flex.SetRect(0, 0, 20, 4)
+	flex.Draw(screen)
+
+	box.SetWidth(3).SetHeight(2)
+	if _, _, width, height := box.GetRect(); width != 5 || height != 4 {
+		t.Errorf("managed box is %dx%d after SetWidth() and SetHeight(), expected 5x4", width, height)
+	}
+
+	box.SetMinSize(8, 0)
+	if _, _, width, _ := box.GetRect(); width != 5 {
+		t.Errorf("managed box is %d wide after SetMinSize() before drawing, expected 5", width)
+	}
+	flex.Draw(screen)
+	if _, _, width, _ := box.GetRect(); width != 8 {
+		t.Errorf("managed box is %d wide after drawing, expected 8", width)
+	}
+}
+
+// TestBoxTheme checks that theme colors are read when the box is drawn and
+// that colors set explicitly override them.
+func TestBoxTheme(t *testing.T) {
+	theme := Styles
+	box := NewBox().SetTheme(&theme)
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		t.Fatal(err)
+	}
+	defer screen.Fini()
+	box.SetRect(0, 0, 10, 5)
+
+	theme.BorderColor = tcell.ColorRed
+	theme.PrimitiveBackgroundColor = tcell.ColorGreen
+	box.Draw(screen)
+	if color := box.GetBorderColor(); color != tcell.ColorRed {
+		t.Errorf("border color is %v after changing the theme, expected red", color)
+	}
+	if color := box.GetBackgroundColor(); color != tcell.ColorGreen {
+		t.Errorf("background color is %v after changing the theme, expected green", color)
+	}
+
+	box.SetBackgroundColor(tcell.ColorBlue)
+	theme.PrimitiveBackgroundColor = tcell.ColorYellow
+	box.Draw(screen)
+	if color := box.GetBackgroundColor(); color != tcell.ColorBlue {
+		t.Errorf("background color is %v, expected the explicitly set blue", color)
+	}
+}
@@ -0,0 +1,218 @@
+package tview
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// bufferCell is one cell of a Buffer.
+type bufferCell struct {
+	mainc rune
+	combc []rune
+	style tcell.Style
+
+	// Whether anything was drawn into this cell since the last Clear().
+	set bool
+}
+
+// Buffer is an offscreen grid of cells which implements tcell.Screen, so that
+// primitives can draw into it just like onto a real screen. The result can
+// then be copied onto a screen with Blit(), possibly many times and at
+// different positions, e.g. to cache static content or to compose effects.
+//
+// Only the drawing functions (SetContent(), GetContent(), SetCell(), Fill(),
+// Clear(), Size(), and the like) are implemented by the buffer itself. All
+// other tcell.Screen functions are forwarded to the embedded Screen, which
+// may be set to the application's screen and must not be nil if any of them
+// is called.
+type Buffer struct {
+	tcell.Screen
+
+	// The size of the buffer.
+	width, height int
+
+	// The cells, row by row.
+	cells []bufferCell
+
+	// The style used by Clear().
+	style tcell.Style
+}
+
+// NewBuffer returns a new, empty buffer of the given size.
+func NewBuffer(width, height int) *Buffer {
+	b := &Buffer{}
+	b.SetSize(width, height)
+	return b
+}
+
+// SetSize changes the size of the buffer, clearing its content.
+func (b *Buffer) SetSize(width, height int) {
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	b.width, b.height = width, height
+	b.cells = make([]bufferCell, width*height)
+}
+
+// Size returns the size of the buffer.
+func (b *Buffer) Size() (width, height int) {
+	return b.width, b.height
+}
+
+// SetStyle sets the style used by Clear().
+func (b *Buffer) SetStyle(style tcell.Style) {
+	b.style = style
+}
+
+// Clear resets all cells. Cleared cells are transparent, i.e. they are not
+// copied by Blit().
+func (b *Buffer) Clear() {
+	for index := range b.cells {
+		b.cells[index] = bufferCell{mainc: ' ', style: b.style}
+	}
+}
+
+// Fill sets all cells to the given rune and style.
+func (b *Buffer) Fill(r rune, style tcell.Style) {
+	for index := range b.cells {
+		b.cells[index] = bufferCell{mainc: r, style: style, set: true}
+	}
+}
+
+// SetContent sets the content of the cell at the given position. Positions
+// outside the buffer are ignored.
+func (b *Buffer) SetContent(x, y int, primary rune, combining []rune, style tcell.Style) {
+	if x < 0 || y < 0 || x >= b.width || y >= b.height {
+		return
+	}
+	b.cells[y*b.width+x] = bufferCell{
+		mainc: primary,
+		combc: append([]rune(nil), combining...),
+		style: style,
+		set:   true,
+	}
+}
+
+// SetCell sets the cell at the given position to the given style and runes,
+// the first of which is the primary rune and the rest combining runes.
+func (b *Buffer) SetCell(x, y int, style tcell.Style, ch ...rune) {
+	if len(ch) > 0 {
+		b.SetContent(x, y, ch[0], ch[1:], style)
+	} else {
+		b.SetContent(x, y, ' ', nil, style)
+	}
+}
+
+// GetContent returns the content of the cell at the given position.
+func (b *Buffer) GetContent(x, y int) (primary rune, combining []rune, style tcell.Style, width int) {
+	if x < 0 || y < 0 || x >= b.width || y >= b.height {
+		return ' ', nil, tcell.StyleDefault, 1
+	}
+	cell := b.cells[y*b.width+x]
+	primary, combining, style = cell.mainc, cell.combc, cell.style
+	if primary == 0 {
+		primary = ' '
+	}
+	width = runewidth.RuneWidth(primary)
+	if width < 1 {
+		width = 1
+	}
+	return
+}
+
+// ShowCursor does nothing. Buffers have no cursor.
+func (b *Buffer) ShowCursor(x, y int) {}
+
+// HideCursor does nothing. Buffers have no cursor.
+func (b *Buffer) HideCursor() {}
+
+// Show does nothing. Use Blit() to copy the buffer onto a screen.
+func (b *Buffer) Show() {}
+
+// Sync does nothing. Use Blit() to copy the buffer onto a screen.
+func (b *Buffer) Sync() {}
+
+// Colors returns the number of colors of the embedded screen, or 256 if there
+// is none.
+func (b *Buffer) Colors() int {
+	if b.Screen != nil {
+		return b.Screen.Colors()
+	}
+	return 256
+}
+
+// CanDisplay returns whether the embedded screen can display the given rune,
+// or true if there is no embedded screen.
+func (b *Buffer) CanDisplay(r rune, checkFallbacks bool) bool {
+	if b.Screen != nil {
+		return b.Screen.CanDisplay(r, checkFallbacks)
+	}
+	return true
+}
+
+// Blit copies all cells which were drawn into since the last Clear() onto the
+// given screen, with the buffer's top-left corner at the given position.
+// Cells outside the screen are skipped.
+func (b *Buffer) Blit(screen tcell.Screen, x, y int) {
+	b.BlitAlpha(screen, x, y, 1)
+}
+
+// BlitAlpha is like Blit() but blends the buffer's colors with the colors on
+// the screen. An alpha value of 1 copies the buffer's colors, 0 keeps the
+// screen's colors. The buffer's runes replace the screen's runes if alpha is
+// at least 0.5. Colors which are not RGB colors (such as tcell.ColorDefault)
+// cannot be blended; the buffer's color is used if alpha is at least 0.5.
+func (b *Buffer) BlitAlpha(screen tcell.Screen, x, y int, alpha float64) {
+	if alpha <= 0 {
+		return
+	}
+	if alpha > 1 {
+		alpha = 1
+	}
+	screenWidth, screenHeight := screen.Size()
+	for row := 0; row < b.height; row++ {
+		for column := 0; column < b.width; column++ {
+			cell := b.cells[row*b.width+column]
+			targetX, targetY := x+column, y+row
+			if !cell.set || targetX < 0 || targetY < 0 || targetX >= screenWidth || targetY >= screenHeight {
+				continue
+			}
+			if alpha >= 1 {
+				screen.SetContent(targetX, targetY, cell.mainc, cell.combc, cell.style)
+				continue
+			}
+			mainc, combc, style, _ := screen.GetContent(targetX, targetY)
+			if alpha >= 0.5 {
+				mainc, combc = cell.mainc, cell.combc
+			}
+			fg, bg, _ := style.Decompose()
+			cellFg, cellBg, attributes := cell.style.Decompose()
+			style = style.Foreground(blendColors(fg, cellFg, alpha)).
+				Background(blendColors(bg, cellBg, alpha))
+			if alpha >= 0.5 {
+				style = style.Attributes(attributes)
+			}
+			screen.SetContent(targetX, targetY, mainc, combc, style)
+		}
+	}
+}
+
+// blendColors returns the linear interpolation between the colors from and to
+// at the given position between 0 (from) and 1 (to).
+func blendColors(from, to tcell.Color, alpha float64) tcell.Color {
+	if from == tcell.ColorDefault || to == tcell.ColorDefault || !from.Valid() || !to.Valid() {
+		if alpha >= 0.5 {
+			return to
+		}
+		return from
+	}
+	r1, g1, b1 := from.RGB()
+	r2, g2, b2 := to.RGB()
+	blend := func(a, b int32) int32 {
+		return a + int32(float64(b-a)*alpha+0.5)
+	}
+	return tcell.NewRGBColor(blend(r1, r2), blend(g1, g2), blend(b1, b2))
+}
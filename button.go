@@ -95,6 +95,8 @@ func (b *Button) SetExitFunc(handler func(key tcell.Key)) *Button {
 
 // Draw draws this primitive onto the screen.
 func (b *Button) Draw(screen tcell.Screen) {
+	defer b.DrawDisabled(screen)
+
 	// Draw the box.
 	borderColor := b.GetBorderColor()
 	backgroundColor := b.GetBackgroundColor()
@@ -105,8 +107,11 @@ func (b *Button) Draw(screen tcell.Screen) {
 			b.SetBorderColor(borderColor)
 		}()
 	}
-	b.Box.DrawForSubclass(screen, b)
+	drawn := b.Box.DrawForSubclass(screen, b)
 	b.backgroundColor = backgroundColor
+	if !drawn {
+		return
+	}
 
 	// Draw label.
 	x, y, width, height := b.GetInnerRect()
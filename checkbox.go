@@ -157,7 +157,10 @@ func (c *Checkbox) SetFinishedFunc(handler func(key tcell.Key)) FormItem {
 
 // Draw draws this primitive onto the screen.
 func (c *Checkbox) Draw(screen tcell.Screen) {
-	c.Box.DrawForSubclass(screen, c)
+	if !c.Box.DrawForSubclass(screen, c) {
+		return
+	}
+	defer c.DrawDisabled(screen)
 
 	// Prepare
 	x, y, width, height := c.GetInnerRect()
@@ -0,0 +1,201 @@
+package tview
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// DefaultChordTimeout is the default maximum time between two consecutive key
+// presses of a chord.
+var DefaultChordTimeout = time.Second
+
+// ChordKey describes one key press of a chord. For KeyRune keys, Rune holds
+// the character. Mod holds the modifier keys which must be pressed.
+type ChordKey struct {
+	Key  tcell.Key
+	Rune rune
+	Mod  tcell.ModMask
+}
+
+// RuneChord returns a chord sequence consisting of the characters of the
+// given string, e.g. RuneChord("gg") for pressing "g" twice.
+func RuneChord(runes string) []ChordKey {
+	var keys []ChordKey
+	for _, r := range runes {
+		keys = append(keys, ChordKey{Key: tcell.KeyRune, Rune: r})
+	}
+	return keys
+}
+
+// matches returns whether the given key event corresponds to this chord key.
+func (k ChordKey) matches(event *tcell.EventKey) bool {
+	if k.Key != event.Key() || k.Mod != event.Modifiers() {
+		return false
+	}
+	return k.Key != tcell.KeyRune || k.Rune == event.Rune()
+}
+
+// chord is a registered key sequence with its action.
+type chord struct {
+	sequence []ChordKey
+	action   func()
+}
+
+// ChordMatcher recognizes multi-key sequences ("chords") such as "g g" or
+// "d d" in Vim. Install its Capture() function as (or call it from) the
+// application's input capture:
+//
+//	matcher := tview.NewChordMatcher().
+//		AddChord(tview.RuneChord("gg"), scrollToTop)
+//	app.SetInputCapture(matcher.Capture)
+//
+// Keys which start a registered chord are buffered and not forwarded. When a
+// chord is complete, its action is invoked. A key which neither completes nor
+// continues a chord flushes the buffer: the buffered keys are discarded (see
+// SetFlushedFunc()) and the key itself is either the start of a new chord or
+// forwarded as usual. If the time between two keys exceeds the chord timeout
+// (see SetChordTimeout()), the buffer is flushed, too.
+type ChordMatcher struct {
+	sync.Mutex
+
+	// The registered chords.
+	chords []*chord
+
+	// The keys of a partially matched chord.
+	buffer []*tcell.EventKey
+
+	// The time of the last buffered key.
+	last time.Time
+
+	// The maximum time between two keys of a chord.
+	timeout time.Duration
+
+	// An optional function which receives buffered keys that were discarded.
+	flushed func(events []*tcell.EventKey)
+}
+
+// NewChordMatcher returns a new chord matcher without any chords.
+func NewChordMatcher() *ChordMatcher {
+	return &ChordMatcher{
+		timeout: DefaultChordTimeout,
+	}
+}
+
+// SetChordTimeout sets the maximum time between two consecutive keys of a
+// chord. If it is exceeded, the keys entered so far are flushed.
+func (c *ChordMatcher) SetChordTimeout(timeout time.Duration) *ChordMatcher {
+	c.Lock()
+	defer c.Unlock()
+	c.timeout = timeout
+	return c
+}
+
+// SetFlushedFunc sets a handler which receives the buffered keys whenever
+// they are discarded without completing a chord. This may be used to replay
+// them, e.g. with Application.QueueEvent().
+func (c *ChordMatcher) SetFlushedFunc(handler func(events []*tcell.EventKey)) *ChordMatcher {
+	c.Lock()
+	defer c.Unlock()
+	c.flushed = handler
+	return c
+}
+
+// AddChord registers a key sequence and the action which is invoked when the
+// sequence was entered. Sequences which are a prefix of other sequences are
+// never matched.
+func (c *ChordMatcher) AddChord(sequence []ChordKey, action func()) *ChordMatcher {
+	c.Lock()
+	defer c.Unlock()
+	if len(sequence) > 0 {
+		c.chords = append(c.chords, &chord{sequence: sequence, action: action})
+	}
+	return c
+}
+
+// Clear removes all chords and empties the buffer.
+func (c *ChordMatcher) Clear() *ChordMatcher {
+	c.Lock()
+	defer c.Unlock()
+	c.chords = nil
+	c.buffer = nil
+	return c
+}
+
+// IsPending returns whether keys of a partially entered chord are buffered.
+func (c *ChordMatcher) IsPending() bool {
+	c.Lock()
+	defer c.Unlock()
+	return len(c.buffer) > 0
+}
+
+// Capture processes a key event. It returns nil if the event was consumed as
+// part of a chord and the event itself otherwise. Its signature matches that
+// of input capture functions.
+func (c *ChordMatcher) Capture(event *tcell.EventKey) *tcell.EventKey {
+	if event == nil {
+		return nil
+	}
+
+	c.Lock()
+	var discarded []*tcell.EventKey
+	if len(c.buffer) > 0 && c.timeout > 0 && time.Since(c.last) > c.timeout {
+		discarded, c.buffer = c.buffer, nil
+	}
+
+	c.buffer = append(c.buffer, event)
+	action, partial := c.match()
+	if action == nil && !partial && len(c.buffer) > 1 {
+		// The key does not continue the chord. Maybe it starts a new one.
+		discarded = append(discarded, c.buffer[:len(c.buffer)-1]...)
+		c.buffer = []*tcell.EventKey{event}
+		action, partial = c.match()
+	}
+
+	if action != nil || !partial {
+		c.buffer = nil
+	} else {
+		c.last = time.Now()
+	}
+	flushed := c.flushed
+	c.Unlock()
+
+	if len(discarded) > 0 && flushed != nil {
+		flushed(discarded)
+	}
+	if action != nil {
+		action()
+		return nil
+	}
+	if partial {
+		return nil
+	}
+	return event
+}
+
+// match compares the buffered keys with the registered chords. It returns the
+// action of a completely matched chord or whether the buffer is the prefix of
+// at least one chord.
+func (c *ChordMatcher) match() (action func(), partial bool) {
+ChordLoop:
+	for _, ch := range c.chords {
+		if len(c.buffer) > len(ch.sequence) {
+			continue
+		}
+		for index, event := range c.buffer {
+			if !ch.sequence[index].matches(event) {
+				continue ChordLoop
+			}
+		}
+		if len(c.buffer) < len(ch.sequence) {
+			partial = true
+		} else if action == nil {
+			action = ch.action
+		}
+	}
+	if partial {
+		action = nil // Longer sequences take precedence.
+	}
+	return
+}
@@ -0,0 +1,98 @@
+package tview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// runeKey returns a key event for the given character.
+func runeKey(r rune) *tcell.EventKey {
+	return tcell.NewEventKey(tcell.KeyRune, r, tcell.ModNone)
+}
+
+// TestChordMatcher checks which keys a ChordMatcher consumes, forwards, and
+// flushes, and which chord actions it invokes.
+func TestChordMatcher(t *testing.T) {
+	var actions []string
+	var flushed string
+	matcher := NewChordMatcher().
+		AddChord(RuneChord("gg"), func() { actions = append(actions, "gg") }).
+		AddChord(RuneChord("dd"), func() { actions = append(actions, "dd") }).
+		AddChord(RuneChord("dw"), func() { actions = append(actions, "dw") }).
+		AddChord([]ChordKey{{Key: tcell.KeyCtrlX, Mod: tcell.ModCtrl}, {Key: tcell.KeyRune, Rune: 's'}}, func() { actions = append(actions, "C-x s") }).
+		SetFlushedFunc(func(events []*tcell.EventKey) {
+			for _, event := range events {
+				flushed += string(event.Rune())
+			}
+		})
+
+	tests := []struct {
+		name      string
+		keys      []*tcell.EventKey
+		forwarded string // The runes of the keys which are returned.
+		actions   string // The actions invoked, space-separated.
+		flushed   string // The runes of the discarded keys.
+	}{
+		{"complete chord", []*tcell.EventKey{runeKey('g'), runeKey('g')}, "", "gg", ""},
+		{"unrelated key", []*tcell.EventKey{runeKey('x')}, "x", "", ""},
+		{"shared prefix", []*tcell.EventKey{runeKey('d'), runeKey('w'), runeKey('d'), runeKey('d')}, "", "dw dd", ""},
+		{"broken chord", []*tcell.EventKey{runeKey('g'), runeKey('x')}, "x", "", "g"},
+		{"new chord after broken chord", []*tcell.EventKey{runeKey('g'), runeKey('d'), runeKey('d')}, "", "dd", "g"},
+		{"special key", []*tcell.EventKey{tcell.NewEventKey(tcell.KeyCtrlX, 0, tcell.ModCtrl), runeKey('s')}, "", "C-x s", ""},
+	}
+	for _, test := range tests {
+		actions, flushed = nil, ""
+		var forwarded string
+		for _, key := range test.keys {
+			if event := matcher.Capture(key); event != nil {
+				forwarded += string(event.Rune())
+			}
+		}
+		if forwarded != test.forwarded {
+			t.Errorf("%s: forwarded %q, expected %q", test.name, forwarded, test.forwarded)
+		}
+		var invoked string
+		for index, action := range actions {
+			if index > 0 {
+				invoked += " "
+			}
+			invoked += action
+		}
+		if invoked != test.actions {
+			t.Errorf("%s: invoked %q, expected %q", test.name, invoked, test.actions)
+		}
+		if flushed != test.flushed {
+			t.Errorf("%s: flushed %q, expected %q", test.name, flushed, test.flushed)
+		}
+		if matcher.IsPending() {
+			t.Errorf("%s: keys still pending", test.name)
+		}
+	}
+}
+
+// TestChordMatcherTimeout checks that a chord is not completed if the time
+// between its keys exceeds the timeout.
+func TestChordMatcherTimeout(t *testing.T) {
+	completed := false
+	var flushed int
+	matcher := NewChordMatcher().
+		SetChordTimeout(10*time.Millisecond).
+		AddChord(RuneChord("gg"), func() { completed = true }).
+		SetFlushedFunc(func(events []*tcell.EventKey) { flushed += len(events) })
+
+	if event := matcher.Capture(runeKey('g')); event != nil || !matcher.IsPending() {
+		t.Fatalf("first key: returned %v, pending %v, expected it to be buffered", event, matcher.IsPending())
+	}
+	time.Sleep(20 * time.Millisecond)
+	if event := matcher.Capture(runeKey('g')); event != nil {
+		t.Errorf("second key returned %v, expected it to start a new chord", event)
+	}
+	if completed || flushed != 1 {
+		t.Errorf("completed %v, flushed %d keys, expected the first key to be flushed", completed, flushed)
+	}
+	if !matcher.IsPending() {
+		t.Error("second key is not pending")
+	}
+}
@@ -0,0 +1,92 @@
+package tview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// ClippedScreen wraps a tcell.Screen and silently drops all writes outside a
+// clipping rectangle. It lets primitives draw without checking bounds
+// everywhere, knowing that nothing bleeds past the rectangle, e.g. over their
+// border. All other functions are forwarded to the wrapped screen.
+type ClippedScreen struct {
+	tcell.Screen
+
+	// The clipping rectangle.
+	x, y, width, height int
+}
+
+// NewClippedScreen returns a screen which forwards to the given screen but
+// drops writes outside the given rectangle. If the given screen is itself a
+// ClippedScreen, the new clipping rectangle is the intersection of both.
+func NewClippedScreen(screen tcell.Screen, x, y, width, height int) ClippedScreen {
+	if clipped, ok := screen.(ClippedScreen); ok {
+		right, bottom := x+width, y+height
+		if clipped.x > x {
+			x = clipped.x
+		}
+		if clipped.y > y {
+			y = clipped.y
+		}
+		if clipped.x+clipped.width < right {
+			right = clipped.x + clipped.width
+		}
+		if clipped.y+clipped.height < bottom {
+			bottom = clipped.y + clipped.height
+		}
+		return ClippedScreen{Screen: clipped.Screen, x: x, y: y, width: right - x, height: bottom - y}
+	}
+	return ClippedScreen{Screen: screen, x: x, y: y, width: width, height: height}
+}
+
+// GetClipRect returns the clipping rectangle.
+func (c ClippedScreen) GetClipRect() (x, y, width, height int) {
+	return c.x, c.y, c.width, c.height
+}
+
+// InClipRect returns whether the given position is inside the clipping
+// rectangle.
+func (c ClippedScreen) InClipRect(x, y int) bool {
+	return x >= c.x && x < c.x+c.width && y >= c.y && y < c.y+c.height
+}
+
+// SetContent sets the content of the cell at the given position if it is
+// inside the clipping rectangle.
+func (c ClippedScreen) SetContent(x, y int, primary rune, combining []rune, style tcell.Style) {
+	if c.InClipRect(x, y) {
+		c.Screen.SetContent(x, y, primary, combining, style)
+	}
+}
+
+// SetCell sets the cell at the given position if it is inside the clipping
+// rectangle.
+func (c ClippedScreen) SetCell(x, y int, style tcell.Style, ch ...rune) {
+	if c.InClipRect(x, y) {
+		c.Screen.SetCell(x, y, style, ch...)
+	}
+}
+
+// ClipScreen returns a screen which forwards to the given screen but drops all
+// writes outside the box's inner rectangle (see GetInnerRect()). Subclasses
+// may draw their content onto it after DrawForSubclass() without spilling
+// over their border:
+//
+//	if !v.Box.DrawForSubclass(screen, v) {
+//		return
+//	}
+//	screen = v.ClipScreen(screen)
+func (b *Box) ClipScreen(screen tcell.Screen) tcell.Screen {
+	x, y, width, height := b.GetInnerRect()
+	return NewClippedScreen(screen, x, y, width, height)
+}
+
+// WithClip calls the given function with a screen which drops all writes
+// outside the box's inner rectangle (see GetInnerRect()). Subclasses may use
+// it to draw their content without bounds checks:
+//
+//	b.WithClip(screen, func(clipped ClippedScreen) {
+//		printWithStyle(clipped, text, x, y, 0, width, AlignLeft, style, true)
+//	})
+func (b *Box) WithClip(screen tcell.Screen, fn func(clipped ClippedScreen)) {
+	x, y, width, height := b.GetInnerRect()
+	fn(NewClippedScreen(screen, x, y, width, height))
+}
@@ -0,0 +1,265 @@
+package tview
+
+import (
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// collapsibleFrameInterval is the time between two frames of a
+// CollapsibleBox animation.
+const collapsibleFrameInterval = 16 * time.Millisecond
+
+// CollapsibleBox is a bordered section which shows a content primitive when
+// expanded and only its title when collapsed, e.g. a group of settings.
+// Clicking the title toggles the section.
+//
+// The box reports its height through GetPreferredSize(). Whenever the height
+// changes, an EventSetRect event with the new rectangle is sent to the evented
+// function (see SetEventedFunc()) so that the parent layout can reflow. If the
+// box was added to a Flex, its fixed size in the Flex is updated
+// automatically.
+//
+// If an application and a duration are set with SetAnimation(), the height
+// changes gradually.
+type CollapsibleBox struct {
+	*Box
+
+	// The primitive shown when the box is expanded.
+	content Primitive
+
+	// Whether the box is expanded.
+	expanded bool
+
+	// The height of the expanded box, 0 to determine it from the content.
+	expandedHeight int
+
+	// The application used to schedule animation frames and the duration of
+	// the animation. Changes are immediate if either is unset.
+	app      *Application
+	duration time.Duration
+
+	// The height during an animation and a counter identifying the current
+	// animation.
+	animatedHeight int
+	animation      int
+}
+
+// NewCollapsibleBox returns a new, expanded box with a border, the given
+// title, and the given content.
+func NewCollapsibleBox(title string, content Primitive) *CollapsibleBox {
+	c := &CollapsibleBox{
+		Box:      NewBox(),
+		content:  content,
+		expanded: true,
+	}
+	c.SetBorder(true).SetTitle(title).SetTitleAlign(AlignLeft)
+	c.SetOnTitleClick(func() {
+		c.SetExpanded(!c.expanded)
+	})
+	return c
+}
+
+// SetContent sets the primitive shown when the box is expanded.
+func (c *CollapsibleBox) SetContent(content Primitive) *CollapsibleBox {
+	c.content = content
+	return c
+}
+
+// GetContent returns the primitive shown when the box is expanded.
+func (c *CollapsibleBox) GetContent() Primitive {
+	return c.content
+}
+
+// SetExpandedHeight sets the total height of the expanded box, including its
+// border and padding. A value of 0 (the default) uses the preferred height of
+// the content if it implements PreferredSizer and 10 rows otherwise.
+func (c *CollapsibleBox) SetExpandedHeight(height int) *CollapsibleBox {
+	c.expandedHeight = height
+	c.setHeight(c.targetHeight())
+	return c
+}
+
+// SetAnimation sets the application used to redraw the box while it animates
+// its height and the duration of the animation. If the application is nil or
+// the duration is 0 (the default), the height changes immediately.
+func (c *CollapsibleBox) SetAnimation(app *Application, duration time.Duration) *CollapsibleBox {
+	c.app, c.duration = app, duration
+	return c
+}
+
+// SetExpanded expands or collapses the box. This must be called from the
+// application's main goroutine (e.g. in an event handler or in
+// Application.QueueUpdate()) if an animation is set.
+func (c *CollapsibleBox) SetExpanded(expanded bool) *CollapsibleBox {
+	if expanded == c.expanded {
+		return c
+	}
+	from := c.height
+	if c.animating {
+		from = c.animatedHeight
+	}
+	c.expanded = expanded
+	c.animate(from, c.targetHeight())
+	return c
+}
+
+// IsExpanded returns whether the box is expanded.
+func (c *CollapsibleBox) IsExpanded() bool {
+	return c.expanded
+}
+
+// GetPreferredSize returns the current height of the box, which changes
+// gradually during an animation. The width is not constrained.
+func (c *CollapsibleBox) GetPreferredSize() (width, height int) {
+	if c.animating {
+		return 0, c.animatedHeight
+	}
+	return 0, c.targetHeight()
+}
+
+// targetHeight returns the height of the box in its current state.
+func (c *CollapsibleBox) targetHeight() int {
+	top, bottom, _, _ := c.borderWidths()
+	if !c.expanded {
+		if top > 0 {
+			return top
+		}
+		return 1
+	}
+	if c.expandedHeight > 0 {
+		return c.expandedHeight
+	}
+	if sizer, ok := c.content.(PreferredSizer); ok {
+		if _, height := sizer.GetPreferredSize(); height > 0 {
+			return height + top + bottom + c.paddingTop + c.paddingBottom
+		}
+	}
+	return 10
+}
+
+// animate changes the height of the box from one value to another, either
+// immediately or, if an animation is set, over the animation's duration.
+func (c *CollapsibleBox) animate(from, to int) {
+	c.animation++
+	if c.app == nil || c.duration <= 0 || from == to {
+		c.SetAnimating(false)
+		c.setHeight(to)
+		return
+	}
+	animation, start, duration := c.animation, time.Now(), c.duration
+	c.SetAnimating(true)
+	c.animatedHeight = from
+	go func() {
+		ticker := time.NewTicker(collapsibleFrameInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			progress := float64(time.Since(start)) / float64(duration)
+			if progress > 1 {
+				progress = 1
+			}
+			c.app.QueueUpdateDraw(func() {
+				if animation != c.animation {
+					return // A newer animation took over.
+				}
+				c.animatedHeight = from + int(float64(to-from)*progress+0.5)
+				if progress >= 1 {
+					c.SetAnimating(false)
+				}
+				c.setHeight(c.animatedHeight)
+			})
+			if progress >= 1 {
+				return
+			}
+		}
+	}()
+}
+
+// setHeight notifies the parent layout of a new height.
+func (c *CollapsibleBox) setHeight(height int) {
+	if flex, ok := c.parent.(*Flex); ok {
+		flex.ResizeItem(c, height, 0)
+	}
+	c.Event(func(f EventedFunc) {
+		f(EventSetRect, c, c.x, c.y, c.width, height)
+	})
+}
+
+// Draw draws this primitive onto the screen.
+func (c *CollapsibleBox) Draw(screen tcell.Screen) {
+	if !c.Box.DrawForSubclass(screen, c) || c.content == nil {
+		return
+	}
+	if !c.expanded && !c.animating {
+		return
+	}
+	x, y, width, height := c.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+	c.content.SetRect(x, y, width, height)
+	c.content.Draw(screen)
+}
+
+// Focus is called when this primitive receives focus.
+func (c *CollapsibleBox) Focus(delegate func(p Primitive)) {
+	if c.content != nil && c.expanded {
+		delegate(c.content)
+	} else {
+		c.Box.Focus(delegate)
+	}
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (c *CollapsibleBox) HasFocus() bool {
+	if c.content != nil && c.expanded && c.content.HasFocus() {
+		return true
+	}
+	return c.Box.HasFocus()
+}
+
+// containedPrimitives returns the content, unless it is collapsed and
+// visibleOnly is true. See primitiveContainer.
+func (c *CollapsibleBox) containedPrimitives(visibleOnly bool) []Primitive {
+	if c.content == nil || (visibleOnly && !c.expanded) {
+		return nil
+	}
+	return []Primitive{c.content}
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (c *CollapsibleBox) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+	return c.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		if !c.InRect(event.Position()) {
+			return false, nil
+		}
+
+		// Pass mouse events on to the content.
+		if c.content != nil && c.expanded {
+			if consumed, capture = c.content.MouseHandler()(action, event, setFocus); consumed {
+				return
+			}
+		}
+
+		if action == MouseLeftClick {
+			setFocus(c)
+			consumed = true
+		}
+		return
+	})
+}
+
+// InputHandler returns the handler for this primitive.
+func (c *CollapsibleBox) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
+	return c.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		if c.content != nil && c.expanded && c.content.HasFocus() {
+			if handler := c.content.InputHandler(); handler != nil {
+				handler(event, setFocus)
+				return
+			}
+		}
+		if event.Key() == tcell.KeyEnter || event.Key() == tcell.KeyRune && event.Rune() == ' ' {
+			c.SetExpanded(!c.expanded)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package tview
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// TestCollapsibleBoxResizesFlexItem checks that collapsing and expanding a
+// CollapsibleBox changes its size in the Flex it was added to.
+func TestCollapsibleBoxResizesFlexItem(t *testing.T) {
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		t.Fatal(err)
+	}
+	defer screen.Fini()
+	screen.SetSize(20, 20)
+
+	section := NewCollapsibleBox("Section", NewBox()).SetExpandedHeight(8)
+	filler := NewBox()
+	flex := NewFlex().SetDirection(FlexRow).
+		AddItem(section, 8, 0, false).
+		AddItem(filler, 0, 1, false)
+	flex.SetRect(0, 0, 20, 20)
+
+	if parent := section.GetParent(); parent != flex {
+		t.Fatalf("parent of the section is %v, expected the flex", parent)
+	}
+
+	tests := []struct {
+		expanded bool
+		height   int
+	}{
+		{false, 1},
+		{true, 8},
+	}
+	for _, test := range tests {
+		section.SetExpanded(test.expanded)
+		flex.Draw(screen)
+		if _, _, _, height := section.GetRect(); height != test.height {
+			t.Errorf("expanded %v: section height is %d, expected %d", test.expanded, height, test.height)
+		}
+		if _, y, _, _ := filler.GetRect(); y != test.height {
+			t.Errorf("expanded %v: filler starts at row %d, expected %d", test.expanded, y, test.height)
+		}
+	}
+
+	// Removing the section clears its parent.
+	flex.RemoveItem(section)
+	if parent := section.GetParent(); parent != nil {
+		t.Errorf("parent of the removed section is %v, expected nil", parent)
+	}
+}
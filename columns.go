@@ -0,0 +1,205 @@
+package tview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// Columns displays the content of a TextView in a number of equally wide
+// columns, like a newspaper. Text flows from the bottom of one column to the
+// top of the next. Word wrapping, colors, and regions are handled by the
+// TextView, based on the width of a single column.
+//
+// The following keys can be used for navigation:
+//
+//   - j, down arrow: Scroll down by one line.
+//   - k, up arrow: Scroll up by one line.
+//   - g, home: Move to the top.
+//   - G, end: Move to the bottom.
+//   - Ctrl-F, page down: Scroll down by one page (all columns).
+//   - Ctrl-B, page up: Scroll up by one page (all columns).
+type Columns struct {
+	*Box
+
+	// The text view providing the content.
+	text *TextView
+
+	// The number of columns.
+	columnCount int
+
+	// The number of cells between two columns.
+	gutter int
+
+	// The index of the first line shown in the first column.
+	lineOffset int
+
+	// The number of lines shown in all columns the last time we were drawn.
+	pageSize int
+}
+
+// NewColumns returns a new column layout for the given text view. If nil is
+// provided, a new TextView is created. The text view should not have a border
+// as only its content is drawn.
+func NewColumns(text *TextView) *Columns {
+	if text == nil {
+		text = NewTextView()
+	}
+	c := &Columns{
+		Box:         NewBox(),
+		text:        text,
+		columnCount: 2,
+		gutter:      2,
+	}
+	text.SetParent(c)
+	return c
+}
+
+// GetTextView returns the text view providing the content.
+func (c *Columns) GetTextView() *TextView {
+	return c.text
+}
+
+// SetColumnCount sets the number of columns. Values below 1 are treated as 1.
+func (c *Columns) SetColumnCount(columns int) *Columns {
+	if columns < 1 {
+		columns = 1
+	}
+	c.columnCount = columns
+	return c
+}
+
+// SetGutter sets the number of empty cells between two columns.
+func (c *Columns) SetGutter(width int) *Columns {
+	if width < 0 {
+		width = 0
+	}
+	c.gutter = width
+	return c
+}
+
+// ScrollTo scrolls to the given line, as it appears in the first column.
+func (c *Columns) ScrollTo(line int) *Columns {
+	c.lineOffset = line
+	return c
+}
+
+// GetScrollOffset returns the index of the first line shown in the first
+// column.
+func (c *Columns) GetScrollOffset() int {
+	return c.lineOffset
+}
+
+// containedPrimitives returns the text view shown in columns. See
+// primitiveContainer.
+func (c *Columns) containedPrimitives(visibleOnly bool) []Primitive {
+	return []Primitive{c.text}
+}
+
+// Draw draws this primitive onto the screen.
+func (c *Columns) Draw(screen tcell.Screen) {
+	if !c.Box.DrawForSubclass(screen, c) {
+		return
+	}
+
+	// Determine the column sizes.
+	x, y, width, height := c.GetInnerRect()
+	columnWidth := (width - (c.columnCount-1)*c.gutter) / c.columnCount
+	if columnWidth <= 0 || height <= 0 {
+		return
+	}
+	c.pageSize = height * c.columnCount
+
+	t := c.text
+	t.Lock()
+	defer t.Unlock()
+
+	// Re-index for the column width.
+	if columnWidth != t.lastWidth && t.wrap {
+		t.index = nil
+	}
+	t.lastWidth = columnWidth
+	t.reindexBuffer(columnWidth)
+	if t.regions {
+		t.regionInfos = nil
+	}
+	if t.index == nil {
+		return
+	}
+
+	// Keep the last page filled.
+	if c.lineOffset > len(t.index)-c.pageSize {
+		c.lineOffset = len(t.index) - c.pageSize
+	}
+	if c.lineOffset < 0 {
+		c.lineOffset = 0
+	}
+
+	// Draw the columns.
+	t.columnOffset = 0
+	for column := 0; column < c.columnCount; column++ {
+		from := c.lineOffset + column*height
+		if from >= len(t.index) {
+			break
+		}
+		t.drawLines(screen, x+column*(columnWidth+c.gutter), y, columnWidth, height, from)
+	}
+
+	c.DrawOverflow(screen, c.lineOffset > 0, c.lineOffset+c.pageSize < len(t.index))
+}
+
+// InputHandler returns the handler for this primitive.
+func (c *Columns) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
+	return c.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		switch event.Key() {
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'g':
+				c.lineOffset = 0
+			case 'G':
+				c.lineOffset = len(c.text.index)
+			case 'j':
+				c.lineOffset++
+			case 'k':
+				c.lineOffset--
+			}
+		case tcell.KeyHome:
+			c.lineOffset = 0
+		case tcell.KeyEnd:
+			c.lineOffset = len(c.text.index)
+		case tcell.KeyDown:
+			c.lineOffset++
+		case tcell.KeyUp:
+			c.lineOffset--
+		case tcell.KeyPgDn, tcell.KeyCtrlF:
+			c.lineOffset += c.pageSize
+		case tcell.KeyPgUp, tcell.KeyCtrlB:
+			c.lineOffset -= c.pageSize
+		default:
+			if handler := c.text.InputHandler(); handler != nil {
+				handler(event, setFocus)
+			}
+		}
+	})
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (c *Columns) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+	return c.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		if !c.InRect(event.Position()) {
+			return false, nil
+		}
+
+		switch action {
+		case MouseLeftClick:
+			setFocus(c)
+			consumed = true
+		case MouseScrollUp:
+			c.lineOffset--
+			consumed = true
+		case MouseScrollDown:
+			c.lineOffset++
+			consumed = true
+		}
+
+		return
+	})
+}
@@ -0,0 +1,249 @@
+package tview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// detailRow is one key/value pair of a DetailView.
+type detailRow struct {
+	key, value string
+}
+
+// DetailView displays an ordered list of key/value pairs, as typically found in
+// inspector or "details" panes. Keys are right-aligned in a gutter on the left,
+// values are word-wrapped in the remaining width. Rows may be styled in
+// alternating colors. If the rows don't fit, the view can be scrolled:
+//
+//   - j, down arrow: Scroll down by one line.
+//   - k, up arrow: Scroll up by one line.
+//   - g, home: Move to the top.
+//   - G, end: Move to the bottom.
+//   - Ctrl-F, page down: Scroll down by one page.
+//   - Ctrl-B, page up: Scroll up by one page.
+//
+// Keys and values may contain color tags.
+type DetailView struct {
+	*Box
+
+	// The rows in the order they were added.
+	rows []detailRow
+
+	// The width of the key column. If 0, it is the width of the longest key.
+	keyWidth int
+
+	// The text between the key column and the value column.
+	separator string
+
+	// The styles of keys and values.
+	keyStyle, valueStyle tcell.Style
+
+	// If not equal to the value style, every other row is drawn in this style.
+	alternateStyle tcell.Style
+
+	// The index of the first line shown.
+	lineOffset int
+
+	// The number of wrapped lines and the visible height as of the last call
+	// to Draw().
+	lineCount, pageSize int
+}
+
+// NewDetailView returns a new, empty detail view.
+func NewDetailView() *DetailView {
+	valueStyle := tcell.StyleDefault.Foreground(Styles.PrimaryTextColor).Background(Styles.PrimitiveBackgroundColor)
+	return &DetailView{
+		Box:            NewBox(),
+		separator:      "  ",
+		keyStyle:       tcell.StyleDefault.Foreground(Styles.SecondaryTextColor).Background(Styles.PrimitiveBackgroundColor),
+		valueStyle:     valueStyle,
+		alternateStyle: valueStyle,
+	}
+}
+
+// AddRow appends a key/value pair to the view.
+func (d *DetailView) AddRow(key, value string) *DetailView {
+	d.rows = append(d.rows, detailRow{key: key, value: value})
+	return d
+}
+
+// GetRowCount returns the number of key/value pairs.
+func (d *DetailView) GetRowCount() int {
+	return len(d.rows)
+}
+
+// Clear removes all rows and scrolls back to the top.
+func (d *DetailView) Clear() *DetailView {
+	d.rows = nil
+	d.lineOffset = 0
+	return d
+}
+
+// SetKeyWidth sets the width of the key column. A value of 0 (the default)
+// makes it as wide as the longest key.
+func (d *DetailView) SetKeyWidth(width int) *DetailView {
+	d.keyWidth = width
+	return d
+}
+
+// SetSeparator sets the text drawn between the key column and the value
+// column. It defaults to two spaces.
+func (d *DetailView) SetSeparator(separator string) *DetailView {
+	d.separator = separator
+	return d
+}
+
+// SetKeyStyle sets the style of the keys.
+func (d *DetailView) SetKeyStyle(style tcell.Style) *DetailView {
+	d.keyStyle = style
+	return d
+}
+
+// SetValueStyle sets the style of the values.
+func (d *DetailView) SetValueStyle(style tcell.Style) *DetailView {
+	d.valueStyle = style
+	return d
+}
+
+// SetAlternateStyle sets the style of the values of every other row (the
+// second, fourth, etc.). The style's background color spans the entire row,
+// including the key column. Set it to the value style to turn off alternating
+// row styles.
+func (d *DetailView) SetAlternateStyle(style tcell.Style) *DetailView {
+	d.alternateStyle = style
+	return d
+}
+
+// Draw draws this primitive onto the screen.
+func (d *DetailView) Draw(screen tcell.Screen) {
+	if !d.Box.DrawForSubclass(screen, d) {
+		return
+	}
+
+	x, y, width, height := d.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	// Determine the column widths.
+	keyWidth := d.keyWidth
+	if keyWidth <= 0 {
+		for _, row := range d.rows {
+			if w := TaggedStringWidth(row.key); w > keyWidth {
+				keyWidth = w
+			}
+		}
+	}
+	separatorWidth := TaggedStringWidth(d.separator)
+	valueWidth := width - keyWidth - separatorWidth
+	if valueWidth <= 0 {
+		valueWidth = width
+		keyWidth, separatorWidth = 0, 0
+	}
+
+	// Wrap the values.
+	type detailLine struct {
+		key, value string
+		row        int
+	}
+	var lines []detailLine
+	for index, row := range d.rows {
+		wrapped := WordWrap(row.value, valueWidth)
+		if len(wrapped) == 0 {
+			wrapped = []string{""}
+		}
+		for lineIndex, value := range wrapped {
+			line := detailLine{value: value, row: index}
+			if lineIndex == 0 {
+				line.key = row.key
+			}
+			lines = append(lines, line)
+		}
+	}
+	d.lineCount, d.pageSize = len(lines), height
+
+	// Clamp the scroll offset.
+	if d.lineOffset > len(lines)-height {
+		d.lineOffset = len(lines) - height
+	}
+	if d.lineOffset < 0 {
+		d.lineOffset = 0
+	}
+
+	// Draw the lines.
+	for lineY := 0; lineY < height && d.lineOffset+lineY < len(lines); lineY++ {
+		line := lines[d.lineOffset+lineY]
+		valueStyle := d.valueStyle
+		if line.row%2 == 1 {
+			valueStyle = d.alternateStyle
+		}
+
+		// The value's background spans the entire line.
+		_, background, _ := valueStyle.Decompose()
+		for cx := x; cx < x+width; cx++ {
+			screen.SetContent(cx, y+lineY, ' ', nil, tcell.StyleDefault.Background(background))
+		}
+		if keyWidth > 0 {
+			printWithStyle(screen, line.key, x, y+lineY, 0, keyWidth, AlignRight, d.keyStyle, true)
+			if line.key != "" {
+				printWithStyle(screen, d.separator, x+keyWidth, y+lineY, 0, separatorWidth, AlignLeft, d.keyStyle, true)
+			}
+		}
+		printWithStyle(screen, line.value, x+keyWidth+separatorWidth, y+lineY, 0, valueWidth, AlignLeft, valueStyle, true)
+	}
+
+	d.DrawOverflow(screen, d.lineOffset > 0, d.lineOffset+height < len(lines))
+}
+
+// InputHandler returns the handler for this primitive.
+func (d *DetailView) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
+	return d.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		switch event.Key() {
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'g':
+				d.lineOffset = 0
+			case 'G':
+				d.lineOffset = d.lineCount
+			case 'j':
+				d.lineOffset++
+			case 'k':
+				d.lineOffset--
+			}
+		case tcell.KeyHome:
+			d.lineOffset = 0
+		case tcell.KeyEnd:
+			d.lineOffset = d.lineCount
+		case tcell.KeyDown:
+			d.lineOffset++
+		case tcell.KeyUp:
+			d.lineOffset--
+		case tcell.KeyPgDn, tcell.KeyCtrlF:
+			d.lineOffset += d.pageSize
+		case tcell.KeyPgUp, tcell.KeyCtrlB:
+			d.lineOffset -= d.pageSize
+		}
+	})
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (d *DetailView) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+	return d.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		if !d.InRect(event.Position()) {
+			return false, nil
+		}
+
+		switch action {
+		case MouseLeftClick:
+			setFocus(d)
+			consumed = true
+		case MouseScrollUp:
+			d.lineOffset--
+			consumed = true
+		case MouseScrollDown:
+			d.lineOffset++
+			consumed = true
+		}
+
+		return
+	})
+}
@@ -0,0 +1,162 @@
+package tview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// DiffView displays a line-based diff, with added lines shown in green and
+// removed lines in red, each marked with a "+" or "-" in a gutter. The diff
+// is either computed from two texts with SetDiff() or taken from the output
+// of a tool such as "diff -u" or "git diff" with SetUnifiedDiff().
+//
+// DiffView is based on TextView, so wrapping and scrolling work the same way.
+type DiffView struct {
+	*TextView
+
+	// The colors of added lines, removed lines, unchanged lines, hunk headers
+	// ("@@ ... @@"), and file headers ("---" and "+++").
+	addedColor, removedColor, contextColor, hunkColor, headerColor tcell.Color
+}
+
+// NewDiffView returns a new, empty diff view.
+func NewDiffView() *DiffView {
+	d := &DiffView{
+		TextView:     NewTextView(),
+		addedColor:   tcell.ColorGreen,
+		removedColor: tcell.ColorRed,
+		contextColor: Styles.PrimaryTextColor,
+		hunkColor:    tcell.ColorDarkCyan,
+		headerColor:  Styles.SecondaryTextColor,
+	}
+	d.SetDynamicColors(true).SetWrap(false)
+	return d
+}
+
+// SetColors sets the colors of added lines, removed lines, and unchanged
+// lines. Call this before setting the diff.
+func (d *DiffView) SetColors(added, removed, context tcell.Color) *DiffView {
+	d.addedColor, d.removedColor, d.contextColor = added, removed, context
+	return d
+}
+
+// SetHeaderColors sets the colors of hunk headers ("@@ ... @@") and file
+// headers ("---" and "+++") of unified diffs. Call this before setting the
+// diff.
+func (d *DiffView) SetHeaderColors(hunk, header tcell.Color) *DiffView {
+	d.hunkColor, d.headerColor = hunk, header
+	return d
+}
+
+// SetDiff computes the line differences between the texts a and b and shows
+// all lines of both, marking lines which only appear in a as removed and lines
+// which only appear in b as added.
+//
+// The diff is based on the longest common subsequence of lines, which takes
+// time and memory proportional to the product of the numbers of lines.
+func (d *DiffView) SetDiff(a, b string) *DiffView {
+	var text strings.Builder
+	for _, line := range diffLines(splitDiffLines(a), splitDiffLines(b)) {
+		d.writeLine(&text, line.op, line.text)
+	}
+	d.SetText(text.String())
+	return d
+}
+
+// SetUnifiedDiff shows the given unified diff text, e.g. the output of
+// "diff -u" or "git diff". Lines starting with "+" or "-" are shown as added
+// or removed, and hunk and file headers are highlighted.
+func (d *DiffView) SetUnifiedDiff(diff string) *DiffView {
+	var text strings.Builder
+	for _, line := range splitDiffLines(diff) {
+		switch {
+		case strings.HasPrefix(line, "+++ "), strings.HasPrefix(line, "--- "),
+			strings.HasPrefix(line, "diff "), strings.HasPrefix(line, "index "):
+			fmt.Fprintf(&text, "[#%06x::b]%s[-::-]\n", d.headerColor.Hex(), Escape(line))
+		case strings.HasPrefix(line, "@@"):
+			fmt.Fprintf(&text, "[#%06x]%s[-]\n", d.hunkColor.Hex(), Escape(line))
+		case strings.HasPrefix(line, "+"), strings.HasPrefix(line, "-"), strings.HasPrefix(line, " "):
+			d.writeLine(&text, line[0], line[1:])
+		default:
+			fmt.Fprintf(&text, "%s\n", Escape(line))
+		}
+	}
+	d.SetText(text.String())
+	return d
+}
+
+// writeLine writes one diff line with its gutter to the given builder. The
+// operation is '+', '-', or ' '.
+func (d *DiffView) writeLine(text *strings.Builder, op byte, line string) {
+	color := d.contextColor
+	switch op {
+	case '+':
+		color = d.addedColor
+	case '-':
+		color = d.removedColor
+	}
+	fmt.Fprintf(text, "[#%06x]%c %s[-]\n", color.Hex(), op, Escape(line))
+}
+
+// diffLine is one line of a computed diff.
+type diffLine struct {
+	op   byte // '+' (added), '-' (removed), or ' ' (unchanged).
+	text string
+}
+
+// splitDiffLines splits the given text into lines, ignoring a final newline.
+func splitDiffLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffLines returns the lines of a and b in diff order, based on their longest
+// common subsequence. Removed lines precede added lines where both occur.
+func diffLines(a, b []string) []diffLine {
+	// lengths[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	// Walk the table.
+	lines := make([]diffLine, 0, len(a)+len(b))
+	var i, j int
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{op: ' ', text: a[i]})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			lines = append(lines, diffLine{op: '-', text: a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{op: '+', text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{op: '-', text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{op: '+', text: b[j]})
+	}
+	return lines
+}
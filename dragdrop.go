@@ -0,0 +1,64 @@
+package tview
+
+// DragSource is implemented by primitives from which the user can drag
+// content with the left mouse button. When the button is pressed over the
+// primitive, the application calls DragStart() with the mouse position. If it
+// returns true, the returned payload is carried until the button is released.
+// DragEnd() is then called with the payload and whether a DropTarget accepted
+// it, e.g. so that the source can remove moved content.
+type DragSource interface {
+	Primitive
+	DragStart(x, y int) (payload any, ok bool)
+	DragEnd(payload any, accepted bool)
+}
+
+// DropTarget is implemented by primitives onto which dragged content can be
+// dropped. When the left mouse button is released over the primitive during a
+// drag, the application calls Drop() with the drag source, the payload, and
+// the mouse position. It returns whether the payload was accepted.
+type DropTarget interface {
+	Primitive
+	Drop(source Primitive, payload any, x, y int) bool
+}
+
+// dragState holds the state of a drag-and-drop operation.
+type dragState struct {
+	source  DragSource
+	payload any
+
+	// Whether the mouse was moved with the button pressed. A drag which was
+	// never moved is a click.
+	moved bool
+}
+
+// primitiveAt returns the innermost primitive at the given screen position,
+// among the primitives reachable from p through this package's containers,
+// which satisfies the given condition. nil is returned if there is none.
+func primitiveAt(p Primitive, x, y int, condition func(p Primitive) bool) Primitive {
+	if p == nil || !p.IsVisible() {
+		return nil
+	}
+	rectX, rectY, width, height := p.GetRect()
+	if x < rectX || x >= rectX+width || y < rectY || y >= rectY+height {
+		return nil
+	}
+	children := containerChildren(p, true)
+	for index := len(children) - 1; index >= 0; index-- {
+		if found := primitiveAt(children[index], x, y, condition); found != nil {
+			return found
+		}
+	}
+	if condition(p) {
+		return p
+	}
+	return nil
+}
+
+// ListItemPayload is the payload of items dragged from a List.
+type ListItemPayload struct {
+	Source        *List  // The list the item was dragged from.
+	Index         int    // The index of the item in the source list.
+	MainText      string // The item's main text.
+	SecondaryText string // The item's secondary text.
+	Shortcut      rune   // The item's shortcut.
+}
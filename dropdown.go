@@ -321,7 +321,10 @@ func (d *DropDown) SetFinishedFunc(handler func(key tcell.Key)) FormItem {
 
 // Draw draws this primitive onto the screen.
 func (d *DropDown) Draw(screen tcell.Screen) {
-	d.Box.DrawForSubclass(screen, d)
+	if !d.Box.DrawForSubclass(screen, d) {
+		return
+	}
+	defer d.DrawDisabled(screen)
 
 	// Prepare.
 	x, y, width, height := d.GetInnerRect()
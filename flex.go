@@ -39,6 +39,10 @@ type Flex struct {
 	// If set to true, Flex will use the entire screen as its available space
 	// instead its box dimensions.
 	fullScreen bool
+
+	// An optional function which, if set, enables moving the focus between
+	// items with Tab and Shift-Tab and determines their order.
+	focusOrder FocusOrderFunc
 }
 
 
@@ -96,6 +100,7 @@ func (f *Flex) AddItem(
 	fixedSize, proportion int,
 	focus bool,
 ) *Flex {
+	adoptItem(item, f)
 	f.items = append(
 		f.items,
 		&flexItem{
@@ -116,6 +121,7 @@ func (f *Flex) RemoveItem(p Primitive) *Flex {
 			f.items = append(f.items[:index], f.items[index+1:]...)
 		}
 	}
+	releaseItem(p, f)
 	return f
 }
 
@@ -133,6 +139,8 @@ func (f *Flex) SetItem(index int, p Primitive, fixed, prop int) *flexItem {
 		return nil
 	}
 	if p != nil {
+		releaseItem(f.items[index].Item, f)
+		adoptItem(p, f)
 		f.items[index].Item = p
 		f.ResizeItem(p, fixed, prop)
 	}
@@ -149,6 +157,9 @@ func (f *Flex) GetItem(index int) Primitive {
 
 // Clear removes all items from the container.
 func (f *Flex) Clear() *Flex {
+	for _, item := range f.items {
+		releaseItem(item.Item, f)
+	}
 	f.items = nil
 	return f
 }
@@ -180,7 +191,9 @@ func (f *Flex) ResizeItem(p Primitive, fixedSize, proportion int) *Flex {
 
 // Draw draws this primitive onto the screen.
 func (f *Flex) Draw(screen tcell.Screen) {
-	f.Box.DrawForSubclass(screen, f)
+	if !f.Box.DrawForSubclass(screen, f) {
+		return
+	}
 
 	// Calculate size and position of the items.
 
@@ -223,9 +236,9 @@ func (f *Flex) Draw(screen tcell.Screen) {
 		}
 		if item.Item != nil {
 			if f.direction == FlexColumn {
-				item.Item.SetRect(pos, y, size, height)
+				setItemRect(item.Item, pos, y, size, height)
 			} else {
-				item.Item.SetRect(x, pos, width, size)
+				setItemRect(item.Item, x, pos, width, size)
 			}
 		}
 		pos += size
@@ -240,6 +253,16 @@ func (f *Flex) Draw(screen tcell.Screen) {
 	}
 }
 
+// SetFocusOrderFunc sets a function which determines the order in which the
+// flex items receive focus when the user presses Tab and Shift-Tab. Unless it
+// is set, Tab and Shift-Tab are passed on to the focused item. The function
+// receives the items in the order they were added. Invisible and disabled
+// items are skipped regardless of the returned order.
+func (f *Flex) SetFocusOrderFunc(order FocusOrderFunc) *Flex {
+	f.focusOrder = order
+	return f
+}
+
 // Focus is called when this primitive receives focus.
 func (f *Flex) Focus(delegate func(p Primitive)) {
 	for _, item := range f.items {
@@ -261,6 +284,17 @@ func (f *Flex) HasFocus() bool {
 	return f.Box.HasFocus()
 }
 
+// containedPrimitives returns the flex items in drawing order. See
+// primitiveContainer.
+func (f *Flex) containedPrimitives(visibleOnly bool) (children []Primitive) {
+	for _, item := range f.items {
+		if item.Item != nil {
+			children = append(children, item.Item)
+		}
+	}
+	return
+}
+
 // MouseHandler returns the mouse handler for this primitive.
 func (f *Flex) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
 	return f.WrapMouseHandler(
@@ -289,6 +323,26 @@ func (f *Flex) MouseHandler() func(action MouseAction, event *tcell.EventMouse,
 func (f *Flex) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
 	return f.WrapInputHandler(
 		func(event *tcell.EventKey, setFocus func(p Primitive)) {
+			if key := event.Key(); f.focusOrder != nil && (key == tcell.KeyTab || key == tcell.KeyBacktab) {
+				var (
+					children []Primitive
+					current  Primitive
+				)
+				for _, item := range f.items {
+					if item.Item == nil {
+						continue
+					}
+					children = append(children, item.Item)
+					if item.Item.HasFocus() {
+						current = item.Item
+					}
+				}
+				if next := nextInFocusOrder(children, f.focusOrder, current, key == tcell.KeyBacktab); next != nil {
+					setFocus(next)
+				}
+				return
+			}
+
 			for _, item := range f.items {
 				if item.Item != nil && item.Item.HasFocus() {
 					if handler := item.Item.InputHandler(); handler != nil {
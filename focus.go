@@ -1,6 +1,9 @@
 package tview
 
-import "sync"
+import (
+	"sort"
+	"sync"
+)
 
 // Focusable provides a method which determines if a primitive has focus.
 // Composed primitives may be focused based on the focused state of their
@@ -13,12 +16,23 @@ type focusElement struct {
 	disabled  bool
 }
 
+// focusable returns whether the element can receive focus, i.e. whether it is
+// visible and neither it nor its primitive is disabled.
+func (e *focusElement) focusable() bool {
+	return !e.disabled && e.primitive != nil && e.primitive.IsVisible() && !isDisabled(e.primitive)
+}
+
 // FocusManager manages application focus.
 type FocusManager struct {
 	elements   []*focusElement
 	focused    int
 	wrapAround bool
 	setFocus   func(p Primitive)
+
+	// The primitives whose directional focus targets are computed from their
+	// positions, see AutoLink().
+	linked []Primitive
+
 	sync.RWMutex
 }
 
@@ -38,7 +52,8 @@ func (f *FocusManager) SetWrapAround(wrapAround bool) {
 	f.wrapAround = wrapAround
 }
 
-// Add adds an element to the focus handler.
+// Add adds elements to the focus handler. Next() and Prev() visit them in the
+// order in which they were added.
 func (f *FocusManager) Add(p ...Primitive) {
 	f.Lock()
 	defer f.Unlock()
@@ -73,31 +88,54 @@ func (f *FocusManager) Focus(p Primitive) {
 			break
 		}
 	}
+	if f.focused < 0 || f.focused >= len(f.elements) {
+		return
+	}
 	f.setFocus(f.elements[f.focused].primitive)
 }
 
-// FocusPrevious focuses the previous element.
+// FocusPrevious focuses the previous element, skipping invisible and
+// disabled elements.
 func (f *FocusManager) FocusPrevious() {
 	f.Lock()
 	defer f.Unlock()
-	f.focused--
-	f.updateFocusIndex(true)
+	if !f.updateFocusIndex(f.focused-1, true) {
+		return
+	}
 	f.setFocus(f.elements[f.focused].primitive)
 }
 
-// FocusNext focuses the next element.
+// FocusNext focuses the next element, skipping invisible and disabled
+// elements.
 func (f *FocusManager) FocusNext() {
 	f.Lock()
 	defer f.Unlock()
-	f.focused++
-	f.updateFocusIndex(false)
+	if !f.updateFocusIndex(f.focused+1, false) {
+		return
+	}
 	f.setFocus(f.elements[f.focused].primitive)
 }
 
-// FocusAt focuses the element at the provided index.
+// Next focuses the next element of the tab ring, see FocusNext(). Call
+// SetWrapAround(true) to continue with the first element after the last one.
+func (f *FocusManager) Next() {
+	f.FocusNext()
+}
+
+// Prev focuses the previous element of the tab ring, see FocusPrevious(). Call
+// SetWrapAround(true) to continue with the last element before the first one.
+func (f *FocusManager) Prev() {
+	f.FocusPrevious()
+}
+
+// FocusAt focuses the element at the provided index. Indices out of range are
+// ignored.
 func (f *FocusManager) FocusAt(index int) {
 	f.Lock()
 	defer f.Unlock()
+	if index < 0 || index >= len(f.elements) {
+		return
+	}
 	f.focused = index
 	f.setFocus(f.elements[f.focused].primitive)
 }
@@ -109,53 +147,184 @@ func (f *FocusManager) GetFocusIndex() int {
 	return f.focused
 }
 
-// GetFocusedPrimitive returns the currently focused primitive.
+// GetFocusedPrimitive returns the currently focused primitive, or nil if
+// there are no elements.
 func (f *FocusManager) GetFocusedPrimitive() Primitive {
 	f.Lock()
 	defer f.Unlock()
+	if f.focused < 0 || f.focused >= len(f.elements) {
+		return nil
+	}
 	return f.elements[f.focused].primitive
 }
-func (f *FocusManager) updateFocusIndex(decreasing bool) {
-	for i := 0; i < len(f.elements); i++ {
-		if f.focused < 0 {
-			if f.wrapAround {
-				f.focused = len(f.elements) - 1
-			} else {
-				f.focused = 0
-			}
-		} else if f.focused >= len(f.elements) {
-			if f.wrapAround {
-				f.focused = 0
-			} else {
-				f.focused = len(f.elements) - 1
+
+// updateFocusIndex sets the focus index to the first focusable element at or
+// after the given index, or at or before it if decreasing is true, wrapping
+// around if enabled. If there is no such element, the focus index is left
+// unchanged and false is returned.
+func (f *FocusManager) updateFocusIndex(index int, decreasing bool) bool {
+	step := 1
+	if decreasing {
+		step = -1
+	}
+	count := len(f.elements)
+	for i := 0; i < count; i++ {
+		if index < 0 || index >= count {
+			if !f.wrapAround {
+				return false
 			}
+			index = (index%count + count) % count
 		}
-		item := f.elements[f.focused]
-		if !item.disabled {
-			break
-		}
-		if decreasing {
-			f.focused--
-		} else {
-			f.focused++
+		if f.elements[index].focusable() {
+			f.focused = index
+			return true
 		}
+		index += step
 	}
+	return false
 }
 
 // Transform modifies the current focus.
 func (f *FocusManager) Transform(tr Transformation) {
-	var decreasing bool
 	switch tr {
 	case TransformFirstItem:
-		f.focused = 0
-		decreasing = true
+		f.updateFocusIndex(0, false)
 	case TransformLastItem:
-		f.focused = len(f.elements) - 1
+		f.updateFocusIndex(len(f.elements)-1, true)
 	case TransformPreviousItem:
-		f.focused--
-		decreasing = true
+		f.updateFocusIndex(f.focused-1, true)
 	case TransformNextItem:
-		f.focused++
+		f.updateFocusIndex(f.focused+1, false)
+	}
+}
+
+// FocusOrderFunc receives the children of a container in the order they were
+// added and returns them in the order in which Tab (and, reversed, Shift-Tab)
+// should visit them. Children may be omitted to exclude them from traversal.
+type FocusOrderFunc func(children []Primitive) []Primitive
+
+// nextInFocusOrder returns the child which follows (or, if backwards is true,
+// precedes) the current child in the traversal order determined by the given
+// function. Invisible and disabled children are skipped. If current is not part
+// of the order, the first (or last) child is returned. nil is returned if there
+// is no child which may receive focus.
+func nextInFocusOrder(children []Primitive, order FocusOrderFunc, current Primitive, backwards bool) Primitive {
+	if order != nil {
+		children = order(children)
+	}
+	var candidates []Primitive
+	for _, child := range children {
+		if child != nil && child.IsVisible() && !isDisabled(child) {
+			candidates = append(candidates, child)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	index := -1
+	for i, child := range candidates {
+		if child == current {
+			index = i
+			break
+		}
+	}
+	if backwards {
+		if index <= 0 {
+			index = len(candidates)
+		}
+		return candidates[index-1]
+	}
+	return candidates[(index+1)%len(candidates)]
+}
+
+// AutoLink adds the given primitives to the set of primitives whose
+// directional focus targets (see Box.SetNextFocusableComponents()) are
+// computed from their positions on the screen, and computes them for the
+// whole set. For each primitive and each direction, the other primitives
+// whose centers lie in that direction become the targets, nearest first,
+// preferring those which are aligned with the primitive. Primitives which
+// don't provide SetNextFocusableComponents() are only used as targets.
+//
+// As the positions are only known after the primitives were laid out, i.e.
+// drawn at least once, call Relink() whenever they may have changed.
+func (f *FocusManager) AutoLink(primitives ...Primitive) {
+	f.Lock()
+	f.linked = append(f.linked, primitives...)
+	f.Unlock()
+	f.Relink()
+}
+
+// Relink recomputes the directional focus targets of the primitives added
+// with AutoLink() from their current positions.
+func (f *FocusManager) Relink() {
+	f.RLock()
+	linked := append([]Primitive(nil), f.linked...)
+	f.RUnlock()
+
+	for _, p := range linked {
+		setter, ok := p.(interface {
+			SetNextFocusableComponents(direction FocusDirection, components ...Primitive)
+		})
+		if !ok {
+			continue
+		}
+		for _, direction := range []FocusDirection{Up, Down, Left, Right} {
+			setter.SetNextFocusableComponents(direction, spatialNeighbors(p, linked, direction)...)
+		}
+	}
+}
+
+// spatialNeighbors returns the primitives among the candidates whose centers
+// lie in the given direction of the center of p, ordered by their distance
+// along the direction plus twice their offset across it.
+func spatialNeighbors(p Primitive, candidates []Primitive, direction FocusDirection) []Primitive {
+	// Centers are doubled to avoid rounding.
+	center := func(p Primitive) (x, y int) {
+		x, y, width, height := p.GetRect()
+		return 2*x + width, 2*y + height
+	}
+	abs := func(n int) int {
+		if n < 0 {
+			return -n
+		}
+		return n
+	}
+
+	x, y := center(p)
+	type neighbor struct {
+		primitive Primitive
+		score     int
+	}
+	var neighbors []neighbor
+	for _, candidate := range candidates {
+		if candidate == p {
+			continue
+		}
+		cx, cy := center(candidate)
+		var along, across int
+		switch direction {
+		case Up:
+			along, across = y-cy, cx-x
+		case Down:
+			along, across = cy-y, cx-x
+		case Left:
+			along, across = x-cx, cy-y
+		case Right:
+			along, across = cx-x, cy-y
+		}
+		if along <= 0 {
+			continue
+		}
+		neighbors = append(neighbors, neighbor{candidate, along + 2*abs(across)})
+	}
+	sort.SliceStable(neighbors, func(i, j int) bool {
+		return neighbors[i].score < neighbors[j].score
+	})
+
+	result := make([]Primitive, len(neighbors))
+	for index, n := range neighbors {
+		result[index] = n.primitive
 	}
-	f.updateFocusIndex(decreasing)
+	return result
 }
@@ -0,0 +1,174 @@
+package tview
+
+import "testing"
+
+// TestNextInFocusOrder checks the traversal order of container children with
+// and without a focus order function.
+func TestNextInFocusOrder(t *testing.T) {
+	a, b, c, hidden := NewBox(), NewBox(), NewBox(), NewBox()
+	hidden.SetVisible(false)
+	children := []Primitive{a, hidden, b, c}
+	names := map[Primitive]string{a: "a", b: "b", c: "c", hidden: "hidden", nil: "nil"}
+	reverse := func(children []Primitive) []Primitive {
+		reversed := make([]Primitive, 0, len(children))
+		for index := len(children) - 1; index >= 0; index-- {
+			reversed = append(reversed, children[index])
+		}
+		return reversed
+	}
+	withoutB := func(children []Primitive) []Primitive {
+		var result []Primitive
+		for _, child := range children {
+			if child != b {
+				result = append(result, child)
+			}
+		}
+		return result
+	}
+
+	tests := []struct {
+		name      string
+		order     FocusOrderFunc
+		current   Primitive
+		backwards bool
+		expected  Primitive
+	}{
+		{"insertion order", nil, a, false, b},
+		{"insertion order, wrapping", nil, c, false, a},
+		{"insertion order, backwards", nil, b, true, a},
+		{"insertion order, backwards wrapping", nil, a, true, c},
+		{"no current child", nil, nil, false, a},
+		{"no current child, backwards", nil, nil, true, c},
+		{"reversed", reverse, c, false, b},
+		{"reversed, wrapping", reverse, a, false, c},
+		{"reversed, backwards", reverse, b, true, c},
+		{"omitted child", withoutB, a, false, c},
+		{"omitted current child", withoutB, b, false, a},
+	}
+	for _, test := range tests {
+		if next := nextInFocusOrder(children, test.order, test.current, test.backwards); next != test.expected {
+			t.Errorf("%s: next child is %s, expected %s", test.name, names[next], names[test.expected])
+		}
+	}
+
+	if next := nextInFocusOrder([]Primitive{hidden}, nil, nil, false); next != nil {
+		t.Errorf("only hidden children: next child is %s, expected nil", names[next])
+	}
+}
+
+// TestFocusManagerOrder checks that Next() and Prev() visit elements in the
+// order in which they were added, skipping unfocusable ones.
+func TestFocusManagerOrder(t *testing.T) {
+	a, b, c, d := NewBox(), NewBox(), NewBox(), NewBox()
+	names := map[Primitive]string{a: "a", b: "b", c: "c", d: "d", nil: "nil"}
+	var focused Primitive
+	manager := NewFocusManager(func(p Primitive) { focused = p })
+	manager.Add(a, c)
+	manager.AddAt(1, b)
+	manager.Add(d)
+	c.SetVisible(false)
+
+	for _, test := range []struct {
+		name     string
+		wrap     bool
+		move     func()
+		expected Primitive
+	}{
+		{"next", false, manager.Next, b},
+		{"next, skipping hidden", false, manager.Next, d},
+		{"next at the end", false, manager.Next, d},
+		{"next, wrapping", true, manager.Next, a},
+		{"prev, wrapping", true, manager.Prev, d},
+		{"prev, skipping hidden", true, manager.Prev, b},
+		{"prev", false, manager.Prev, a},
+		{"prev at the start", false, manager.Prev, a},
+	} {
+		manager.SetWrapAround(test.wrap)
+		test.move()
+		if focused != test.expected {
+			t.Errorf("%s: focused %s, expected %s", test.name, names[focused], names[test.expected])
+		}
+	}
+}
+
+// TestFocusManagerSkipsUnfocusable checks that Next() and Prev() skip hidden
+// and disabled elements at either end of the tab ring, with and without
+// wrap-around.
+func TestFocusManagerSkipsUnfocusable(t *testing.T) {
+	tests := []struct {
+		name     string
+		elements []string // "v" visible, "h" hidden, "d" disabled.
+		start    int
+		next     bool
+		wrap     bool
+		expected int // The focus index afterwards, -1 if focus must not be set.
+	}{
+		{"trailing hidden", []string{"v", "h"}, 0, true, false, -1},
+		{"trailing hidden, wrapping", []string{"v", "h"}, 0, true, true, 0},
+		{"trailing disabled", []string{"v", "v", "d"}, 1, true, false, -1},
+		{"trailing disabled, wrapping", []string{"h", "v", "d"}, 1, true, true, 1},
+		{"trailing skipped", []string{"v", "h", "v"}, 0, true, false, 2},
+		{"leading hidden", []string{"h", "v"}, 1, false, false, -1},
+		{"leading hidden, wrapping", []string{"h", "v"}, 1, false, true, 1},
+		{"leading disabled", []string{"d", "v", "v"}, 1, false, false, -1},
+		{"leading disabled, wrapping", []string{"d", "v", "v"}, 1, false, true, 2},
+		{"leading skipped", []string{"v", "d", "v"}, 2, false, false, 0},
+		{"none focusable", []string{"h", "d"}, 0, true, true, -1},
+	}
+
+	for _, test := range tests {
+		focused := -1
+		var elements []Primitive
+		manager := NewFocusManager(func(p Primitive) {
+			for index, element := range elements {
+				if element == p {
+					focused = index
+				}
+			}
+		})
+		for _, kind := range test.elements {
+			box := NewBox()
+			switch kind {
+			case "h":
+				box.SetVisible(false)
+			case "d":
+				box.SetDisabled(true)
+			}
+			elements = append(elements, box)
+		}
+		manager.Add(elements...)
+		manager.SetWrapAround(test.wrap)
+		manager.FocusAt(test.start)
+		focused = -1
+
+		if test.next {
+			manager.Next()
+		} else {
+			manager.Prev()
+		}
+
+		if focused != test.expected {
+			t.Errorf("%s: focused element %d, expected %d", test.name, focused, test.expected)
+		}
+		expectedIndex := test.expected
+		if expectedIndex < 0 {
+			expectedIndex = test.start
+		}
+		if index := manager.GetFocusIndex(); index != expectedIndex {
+			t.Errorf("%s: focus index is %d, expected %d", test.name, index, expectedIndex)
+		}
+	}
+}
+
+// TestFocusManagerEmpty checks that an empty focus manager doesn't panic.
+func TestFocusManagerEmpty(t *testing.T) {
+	manager := NewFocusManager(func(p Primitive) {
+		t.Errorf("focus set to %v without elements", p)
+	})
+	manager.SetWrapAround(true)
+	manager.Next()
+	manager.Prev()
+	if p := manager.GetFocusedPrimitive(); p != nil {
+		t.Errorf("focused primitive is %v, expected nil", p)
+	}
+}
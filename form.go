@@ -80,6 +80,10 @@ type Form struct {
 
 	// An optional function which is called when the user hits Escape.
 	cancel func()
+
+	// An optional function which determines the order in which items and
+	// buttons are visited with Tab and Shift-Tab.
+	focusOrder FocusOrderFunc
 }
 
 // NewForm returns a new form.
@@ -359,9 +363,71 @@ func (f *Form) SetCancelFunc(callback func()) *Form {
 	return f
 }
 
+// SetFocusOrderFunc sets a function which determines the order in which form
+// items and buttons receive focus when the user presses Tab (or Enter) and
+// Shift-Tab. It receives the form items followed by the buttons. Invisible and
+// disabled elements are skipped regardless of the returned order. Set to nil to
+// restore the default order.
+func (f *Form) SetFocusOrderFunc(order FocusOrderFunc) *Form {
+	f.focusOrder = order
+	return f
+}
+
+// children returns the form items followed by the buttons.
+func (f *Form) children() []Primitive {
+	children := make([]Primitive, 0, len(f.items)+len(f.buttons))
+	for _, item := range f.items {
+		children = append(children, item)
+	}
+	for _, button := range f.buttons {
+		children = append(children, button)
+	}
+	return children
+}
+
+// moveFocus advances the focused element according to the focus order
+// function. It returns false if no focus order function is set.
+func (f *Form) moveFocus(backwards bool) bool {
+	if f.focusOrder == nil {
+		return false
+	}
+	children := f.children()
+	var current Primitive
+	if f.focusedElement >= 0 && f.focusedElement < len(children) {
+		current = children[f.focusedElement]
+	}
+	next := nextInFocusOrder(children, f.focusOrder, current, backwards)
+	for index, child := range children {
+		if child == next {
+			f.focusedElement = index
+			break
+		}
+	}
+	return true
+}
+
+// skipDisabled moves the focused element forward (or backward) until it is not
+// disabled, wrapping around at the ends. It stays unchanged if all elements are
+// disabled.
+func (f *Form) skipDisabled(backwards bool) {
+	children := f.children()
+	for count := 0; count < len(children); count++ {
+		if !isDisabled(children[f.focusedElement]) {
+			return
+		}
+		if backwards {
+			f.focusedElement = (f.focusedElement - 1 + len(children)) % len(children)
+		} else {
+			f.focusedElement = (f.focusedElement + 1) % len(children)
+		}
+	}
+}
+
 // Draw draws this primitive onto the screen.
 func (f *Form) Draw(screen tcell.Screen) {
-	f.Box.DrawForSubclass(screen, f)
+	if !f.Box.DrawForSubclass(screen, f) {
+		return
+	}
 
 	// Determine the actual item that has focus.
 	if index := f.focusIndex(); index >= 0 {
@@ -559,15 +625,28 @@ func (f *Form) Focus(delegate func(p Primitive)) {
 	if f.focusedElement < 0 || f.focusedElement >= len(f.items)+len(f.buttons) {
 		f.focusedElement = 0
 	}
+	f.skipDisabled(false)
 	handler := func(key tcell.Key) {
+		// Don't move on if the current element refuses to lose focus.
+		if children := f.children(); (key != tcell.KeyEscape || f.cancel == nil) &&
+			f.focusedElement >= 0 && f.focusedElement < len(children) {
+			if validator, ok := children[f.focusedElement].(BlurValidator); ok && !validator.CanBlur() {
+				return
+			}
+		}
 		switch key {
 		case tcell.KeyTab, tcell.KeyEnter:
-			f.focusedElement++
+			if !f.moveFocus(false) {
+				f.focusedElement++
+			}
 			f.Focus(delegate)
 		case tcell.KeyBacktab:
-			f.focusedElement--
-			if f.focusedElement < 0 {
-				f.focusedElement = len(f.items) + len(f.buttons) - 1
+			if !f.moveFocus(true) {
+				f.focusedElement--
+				if f.focusedElement < 0 {
+					f.focusedElement = len(f.items) + len(f.buttons) - 1
+				}
+				f.skipDisabled(true)
 			}
 			f.Focus(delegate)
 		case tcell.KeyEscape:
@@ -601,6 +680,18 @@ func (f *Form) HasFocus() bool {
 	return f.Box.HasFocus()
 }
 
+// containedPrimitives returns the form items followed by the buttons. See
+// primitiveContainer.
+func (f *Form) containedPrimitives(visibleOnly bool) (children []Primitive) {
+	for _, item := range f.items {
+		children = append(children, item)
+	}
+	for _, button := range f.buttons {
+		children = append(children, button)
+	}
+	return
+}
+
 // focusIndex returns the index of the currently focused item, counting form
 // items first, then buttons. A negative value indicates that no containeed item
 // has focus.
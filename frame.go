@@ -89,7 +89,9 @@ func (f *Frame) SetBorders(top, bottom, header, footer, left, right int) *Frame
 
 // Draw draws this primitive onto the screen.
 func (f *Frame) Draw(screen tcell.Screen) {
-	f.Box.DrawForSubclass(screen, f)
+	if !f.Box.DrawForSubclass(screen, f) {
+		return
+	}
 
 	// Calculate start positions.
 	x, top, width, height := f.GetInnerRect()
@@ -168,6 +170,14 @@ func (f *Frame) HasFocus() bool {
 	return f.primitive.HasFocus()
 }
 
+// containedPrimitives returns the framed primitive. See primitiveContainer.
+func (f *Frame) containedPrimitives(visibleOnly bool) []Primitive {
+	if f.primitive == nil {
+		return nil
+	}
+	return []Primitive{f.primitive}
+}
+
 // MouseHandler returns the mouse handler for this primitive.
 func (f *Frame) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
 	return f.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
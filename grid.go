@@ -59,6 +59,10 @@ type Grid struct {
 
 	// The color of the borders around grid items.
 	bordersColor tcell.Color
+
+	// An optional function which, if set, enables moving the focus between
+	// items with Tab and Shift-Tab and determines their order.
+	focusOrder FocusOrderFunc
 }
 
 // NewGrid returns a new grid-based layout container with no initial primitives.
@@ -199,19 +203,20 @@ func (g *Grid) SetBordersColor(color tcell.Color) *Grid {
 // receives focus. If there are multiple items with a true focus flag, the last
 // visible one that was added will receive focus.
 func (g *Grid) AddItem(a any, row, column, rowSpan, colSpan, minGridHeight, minGridWidth int, focus bool) *Grid {
-  if p, ok := a.(Primitive); ok {
-    g.items = append(g.items, &gridItem{
-      Orig: a,
-      Item:          p,
-      Row:           row,
-      Column:        column,
-      Height:        rowSpan,
-      Width:         colSpan,
-      MinGridHeight: minGridHeight,
-      MinGridWidth:  minGridWidth,
-      Focus:         focus,
-    })
-  }
+	if p, ok := a.(Primitive); ok {
+		adoptItem(p, g)
+		g.items = append(g.items, &gridItem{
+			Orig:          a,
+			Item:          p,
+			Row:           row,
+			Column:        column,
+			Height:        rowSpan,
+			Width:         colSpan,
+			MinGridHeight: minGridHeight,
+			MinGridWidth:  minGridWidth,
+			Focus:         focus,
+		})
+	}
 	return g
 }
 
@@ -223,6 +228,7 @@ func (g *Grid) RemoveItem(p Primitive) *Grid {
 			g.items = append(g.items[:index], g.items[index+1:]...)
 		}
 	}
+	releaseItem(p, g)
 	return g
 }
 
@@ -237,6 +243,9 @@ func (g *Grid) GetItems() []*gridItem {
 	return g.items
 }
 func (g *Grid) Clear() *Grid {
+	for _, item := range g.items {
+		releaseItem(item.Item, g)
+	}
 	g.items = nil
 	return g
 }
@@ -268,6 +277,16 @@ func (g *Grid) GetOffset() (rows, columns int) {
 	return g.rowOffset, g.columnOffset
 }
 
+// SetFocusOrderFunc sets a function which determines the order in which the
+// grid items receive focus when the user presses Tab and Shift-Tab. Unless it
+// is set, Tab and Shift-Tab are passed on to the focused item. The function
+// receives the items in the order they were added. Invisible and disabled
+// items are skipped regardless of the returned order.
+func (g *Grid) SetFocusOrderFunc(order FocusOrderFunc) *Grid {
+	g.focusOrder = order
+	return g
+}
+
 // Focus is called when this primitive receives focus.
 func (g *Grid) Focus(delegate func(p Primitive)) {
 	for _, item := range g.items {
@@ -289,9 +308,35 @@ func (g *Grid) HasFocus() bool {
 	return g.Box.HasFocus()
 }
 
+// containedPrimitives returns the grid items in drawing order. See
+// primitiveContainer.
+func (g *Grid) containedPrimitives(visibleOnly bool) (children []Primitive) {
+	for _, item := range g.items {
+		children = append(children, item.Item)
+	}
+	return
+}
+
 // InputHandler returns the handler for this primitive.
 func (g *Grid) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
 	return g.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		if key := event.Key(); g.focusOrder != nil && (key == tcell.KeyTab || key == tcell.KeyBacktab) {
+			var (
+				children []Primitive
+				current  Primitive
+			)
+			for _, item := range g.items {
+				children = append(children, item.Item)
+				if item.Item.HasFocus() {
+					current = item.Item
+				}
+			}
+			if next := nextInFocusOrder(children, g.focusOrder, current, key == tcell.KeyBacktab); next != nil {
+				setFocus(next)
+			}
+			return
+		}
+
 		if !g.hasFocus {
 			// Pass event on to child primitive.
 			for _, item := range g.items {
@@ -340,7 +385,9 @@ func (g *Grid) InputHandler() func(event *tcell.EventKey, setFocus func(p Primit
 
 // Draw draws this primitive onto the screen.
 func (g *Grid) Draw(screen tcell.Screen) {
-	g.Box.DrawForSubclass(screen, g)
+	if !g.Box.DrawForSubclass(screen, g) {
+		return
+	}
 	x, y, width, height := g.GetInnerRect()
 	screenWidth, screenHeight := screen.Size()
 
@@ -636,7 +683,7 @@ func (g *Grid) Draw(screen tcell.Screen) {
 		}
 		item.x += x
 		item.y += y
-		primitive.SetRect(item.x, item.y, item.w, item.h)
+		setItemRect(primitive, item.x, item.y, item.w, item.h)
 
 		// Draw primitive.
 		if item == focus {
@@ -0,0 +1,257 @@
+package tview
+
+import (
+	"math"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Heatmap displays a two-dimensional grid of values as colored blocks, e.g. an
+// activity graph with one block per day. Values are mapped linearly onto a
+// color scale, from the lowest to the highest value in the data (or the range
+// set with SetRange()). NaN values are left blank.
+//
+// Rows may be labeled on the left (e.g. weekdays) and columns on top (e.g.
+// months). Column labels are only shown if there is room for them, so sparse
+// labels such as one per month can be given by leaving the others empty.
+type Heatmap struct {
+	*Box
+
+	// The values, indexed by row and then column.
+	data [][]float64
+
+	// The colors values are mapped onto, from low to high.
+	colorScale []tcell.Color
+
+	// The value range mapped onto the color scale. If min >= max, the range
+	// is determined from the data.
+	min, max float64
+
+	// The number of screen cells per block and the glyph drawn in them.
+	cellWidth int
+	glyph     rune
+
+	// Optional labels of rows and columns.
+	rowLabels, columnLabels []string
+
+	// The style of the labels.
+	labelStyle tcell.Style
+
+	// An optional function which is called when the mouse is moved over a
+	// block.
+	hover func(row, column int, value float64)
+}
+
+// NewHeatmap returns a new, empty heatmap.
+func NewHeatmap() *Heatmap {
+	return &Heatmap{
+		Box: NewBox(),
+		colorScale: []tcell.Color{
+			tcell.NewHexColor(0x161b22),
+			tcell.NewHexColor(0x0e4429),
+			tcell.NewHexColor(0x006d32),
+			tcell.NewHexColor(0x26a641),
+			tcell.NewHexColor(0x39d353),
+		},
+		cellWidth:  2,
+		glyph:      '█',
+		labelStyle: tcell.StyleDefault.Foreground(Styles.SecondaryTextColor).Background(Styles.PrimitiveBackgroundColor),
+	}
+}
+
+// SetData sets the values to display, indexed by row and then column. Rows
+// may have different lengths.
+func (h *Heatmap) SetData(data [][]float64) *Heatmap {
+	h.data = data
+	return h
+}
+
+// GetData returns the values set with SetData().
+func (h *Heatmap) GetData() [][]float64 {
+	return h.data
+}
+
+// SetColorScale sets the colors which values are mapped onto, from the lowest
+// to the highest value.
+func (h *Heatmap) SetColorScale(colors []tcell.Color) *Heatmap {
+	h.colorScale = colors
+	return h
+}
+
+// SetRange sets the values mapped onto the first and the last color of the
+// color scale. Values outside the range are clamped. If min is not less than
+// max, the range is determined from the data (the default).
+func (h *Heatmap) SetRange(min, max float64) *Heatmap {
+	h.min, h.max = min, max
+	return h
+}
+
+// SetCellWidth sets the number of screen cells used for each block, usually 1
+// or 2 (the default). Two cells make blocks roughly square.
+func (h *Heatmap) SetCellWidth(width int) *Heatmap {
+	if width < 1 {
+		width = 1
+	}
+	h.cellWidth = width
+	return h
+}
+
+// SetGlyph sets the rune drawn for each block, e.g. '█' (the default) or '■'.
+func (h *Heatmap) SetGlyph(glyph rune) *Heatmap {
+	h.glyph = glyph
+	return h
+}
+
+// SetRowLabels sets the labels shown to the left of the rows.
+func (h *Heatmap) SetRowLabels(labels []string) *Heatmap {
+	h.rowLabels = labels
+	return h
+}
+
+// SetColumnLabels sets the labels shown above the columns. Each label starts
+// at its column and is omitted if it would overlap the previous one.
+func (h *Heatmap) SetColumnLabels(labels []string) *Heatmap {
+	h.columnLabels = labels
+	return h
+}
+
+// SetLabelStyle sets the style of row and column labels.
+func (h *Heatmap) SetLabelStyle(style tcell.Style) *Heatmap {
+	h.labelStyle = style
+	return h
+}
+
+// SetHoverFunc sets a handler which is called with the position and value of
+// the block under the mouse when the mouse is moved over the heatmap.
+func (h *Heatmap) SetHoverFunc(handler func(row, column int, value float64)) *Heatmap {
+	h.hover = handler
+	return h
+}
+
+// layout returns the position of the first block and the width of the row
+// label column.
+func (h *Heatmap) layout() (x, y, labelWidth int) {
+	x, y, _, _ = h.GetInnerRect()
+	for _, label := range h.rowLabels {
+		if width := TaggedStringWidth(label); width > labelWidth {
+			labelWidth = width
+		}
+	}
+	if labelWidth > 0 {
+		x += labelWidth + 1
+	}
+	if len(h.columnLabels) > 0 {
+		y++
+	}
+	return
+}
+
+// valueRange returns the values mapped onto the ends of the color scale.
+func (h *Heatmap) valueRange() (min, max float64) {
+	if h.min < h.max {
+		return h.min, h.max
+	}
+	min, max = math.Inf(1), math.Inf(-1)
+	for _, row := range h.data {
+		for _, value := range row {
+			if math.IsNaN(value) {
+				continue
+			}
+			min = math.Min(min, value)
+			max = math.Max(max, value)
+		}
+	}
+	return
+}
+
+// Draw draws this primitive onto the screen.
+func (h *Heatmap) Draw(screen tcell.Screen) {
+	if !h.Box.DrawForSubclass(screen, h) {
+		return
+	}
+	innerX, innerY, width, height := h.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+	x, y, labelWidth := h.layout()
+	right, bottom := innerX+width, innerY+height
+
+	// Labels.
+	for row, label := range h.rowLabels {
+		if y+row >= bottom {
+			break
+		}
+		printWithStyle(screen, label, innerX, y+row, 0, labelWidth, AlignRight, h.labelStyle, true)
+	}
+	labelEnd := x
+	for column, label := range h.columnLabels {
+		labelX := x + column*h.cellWidth
+		if label == "" || labelX < labelEnd || labelX >= right {
+			continue
+		}
+		_, printed, _, _ := printWithStyle(screen, label, labelX, innerY, 0, right-labelX, AlignLeft, h.labelStyle, true)
+		labelEnd = labelX + printed + 1
+	}
+
+	// Blocks.
+	if len(h.colorScale) == 0 {
+		return
+	}
+	min, max := h.valueRange()
+	for row, values := range h.data {
+		if y+row >= bottom {
+			break
+		}
+		for column, value := range values {
+			blockX := x + column*h.cellWidth
+			if blockX+h.cellWidth > right {
+				break
+			}
+			if math.IsNaN(value) {
+				continue
+			}
+			index := 0
+			if max > min {
+				index = int(math.Round((value - min) / (max - min) * float64(len(h.colorScale)-1)))
+			}
+			if index < 0 {
+				index = 0
+			} else if index >= len(h.colorScale) {
+				index = len(h.colorScale) - 1
+			}
+			style := tcell.StyleDefault.Foreground(h.colorScale[index]).Background(h.backgroundColor)
+			for cell := 0; cell < h.cellWidth; cell++ {
+				screen.SetContent(blockX+cell, y+row, h.glyph, nil, style)
+			}
+		}
+	}
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (h *Heatmap) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+	return h.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		mouseX, mouseY := event.Position()
+		if !h.InRect(mouseX, mouseY) {
+			return false, nil
+		}
+		switch action {
+		case MouseLeftClick:
+			setFocus(h)
+			consumed = true
+		case MouseMove:
+			if h.hover == nil {
+				break
+			}
+			x, y, _ := h.layout()
+			if mouseX < x || mouseY < y {
+				break
+			}
+			row, column := mouseY-y, (mouseX-x)/h.cellWidth
+			if row < len(h.data) && column < len(h.data[row]) && !math.IsNaN(h.data[row][column]) {
+				h.hover(row, column, h.data[row][column])
+				consumed = true
+			}
+		}
+		return
+	})
+}
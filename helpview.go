@@ -0,0 +1,134 @@
+package tview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// HelpView displays the bindings of a KeyMap, grouped by category, with the
+// key names in one column and the action descriptions in another. The list is
+// regenerated whenever it is drawn so it always reflects the current bindings.
+// A filter narrows the list down to bindings whose key name, description, or
+// category contains the filter text.
+//
+// HelpView is based on TextView and can be scrolled the same way.
+type HelpView struct {
+	*TextView
+
+	// The key map whose bindings are shown.
+	keyMap *KeyMap
+
+	// Only bindings containing this text (case-insensitive) are shown.
+	filter string
+
+	// The colors of category headers, key names, and descriptions.
+	categoryColor, keyColor, descriptionColor tcell.Color
+
+	// The text generated during the last draw.
+	lastText string
+}
+
+// NewHelpView returns a new help view for the given key map.
+func NewHelpView(keyMap *KeyMap) *HelpView {
+	h := &HelpView{
+		TextView:         NewTextView(),
+		keyMap:           keyMap,
+		categoryColor:    Styles.TertiaryTextColor,
+		keyColor:         Styles.SecondaryTextColor,
+		descriptionColor: Styles.PrimaryTextColor,
+	}
+	h.SetDynamicColors(true).SetWrap(false)
+	return h
+}
+
+// SetKeyMap sets the key map whose bindings are shown.
+func (h *HelpView) SetKeyMap(keyMap *KeyMap) *HelpView {
+	h.keyMap = keyMap
+	return h
+}
+
+// SetFilter only shows bindings whose key name, description, or category
+// contains the given text, ignoring case. An empty text shows all bindings.
+func (h *HelpView) SetFilter(text string) *HelpView {
+	h.filter = text
+	h.ScrollToBeginning()
+	return h
+}
+
+// GetFilter returns the current filter text.
+func (h *HelpView) GetFilter() string {
+	return h.filter
+}
+
+// SetColors sets the colors of category headers, key names, and descriptions.
+func (h *HelpView) SetColors(category, key, description tcell.Color) *HelpView {
+	h.categoryColor, h.keyColor, h.descriptionColor = category, key, description
+	return h
+}
+
+// Draw draws this primitive onto the screen.
+func (h *HelpView) Draw(screen tcell.Screen) {
+	if text := h.format(); text != h.lastText {
+		h.lastText = text
+		h.SetText(text)
+	}
+	h.TextView.Draw(screen)
+}
+
+// format returns the text listing the key map's (filtered) bindings.
+func (h *HelpView) format() string {
+	if h.keyMap == nil {
+		return ""
+	}
+	filter := strings.ToLower(h.filter)
+	bindings := h.keyMap.GetBindings()
+
+	// Apply the filter and determine the width of the key column.
+	var (
+		shown    []*KeyBinding
+		keyWidth int
+	)
+	for _, binding := range bindings {
+		name := binding.Name()
+		if filter != "" &&
+			!strings.Contains(strings.ToLower(name), filter) &&
+			!strings.Contains(strings.ToLower(binding.Description), filter) &&
+			!strings.Contains(strings.ToLower(binding.Category), filter) {
+			continue
+		}
+		shown = append(shown, binding)
+		if width := stringWidth(name); width > keyWidth {
+			keyWidth = width
+		}
+	}
+
+	// Group by category.
+	var (
+		text       strings.Builder
+		categories []string
+		groups     = make(map[string][]*KeyBinding)
+	)
+	for _, binding := range shown {
+		if _, ok := groups[binding.Category]; !ok {
+			categories = append(categories, binding.Category)
+		}
+		groups[binding.Category] = append(groups[binding.Category], binding)
+	}
+	for index, category := range categories {
+		if index > 0 {
+			text.WriteString("\n")
+		}
+		if category != "" {
+			fmt.Fprintf(&text, "[#%06x::b]%s[-::-]\n", h.categoryColor.Hex(), Escape(category))
+		}
+		for _, binding := range groups[category] {
+			name := binding.Name()
+			fmt.Fprintf(&text, "  [#%06x]%s[-]%s  [#%06x]%s[-]\n",
+				h.keyColor.Hex(), Escape(name), strings.Repeat(" ", keyWidth-stringWidth(name)),
+				h.descriptionColor.Hex(), Escape(binding.Description))
+		}
+	}
+	return text.String()
+}
@@ -705,7 +705,9 @@ func (i *Image) stamp(resized [][3]float64) {
 
 // Draw draws this primitive onto the screen.
 func (i *Image) Draw(screen tcell.Screen) {
-	i.DrawForSubclass(screen, i)
+	if !i.DrawForSubclass(screen, i) {
+		return
+	}
 
 	// Regenerate image if necessary.
 	i.render()
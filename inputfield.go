@@ -414,7 +414,10 @@ func (i *InputField) Blur() {
 
 // Draw draws this primitive onto the screen.
 func (i *InputField) Draw(screen tcell.Screen) {
-	i.Box.DrawForSubclass(screen, i)
+	if !i.Box.DrawForSubclass(screen, i) {
+		return
+	}
+	defer i.DrawDisabled(screen)
 
 	// Prepare
 	x, y, width, height := i.GetInnerRect()
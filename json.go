@@ -0,0 +1,108 @@
+package tview
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// JSONTheme defines the colors used by TextView.SetJSON() to highlight the
+// elements of a JSON document.
+type JSONTheme struct {
+	KeyColor         tcell.Color // Object keys.
+	StringColor      tcell.Color // String values.
+	NumberColor      tcell.Color // Number values.
+	BooleanColor     tcell.Color // true and false.
+	NullColor        tcell.Color // null.
+	PunctuationColor tcell.Color // Braces, brackets, colons, and commas.
+	ErrorColor       tcell.Color // The indicator shown for invalid JSON.
+}
+
+// JSONStyles defines the colors used by TextView.SetJSON(). Changes only
+// affect subsequent calls to SetJSON().
+var JSONStyles = JSONTheme{
+	KeyColor:         tcell.ColorDarkCyan,
+	StringColor:      tcell.ColorGreen,
+	NumberColor:      tcell.ColorYellow,
+	BooleanColor:     tcell.ColorFuchsia,
+	NullColor:        tcell.ColorGray,
+	PunctuationColor: tcell.ColorWhite,
+	ErrorColor:       tcell.ColorRed,
+}
+
+// JSONIndent is the indentation used by TextView.SetJSON() for nested
+// elements.
+var JSONIndent = "  "
+
+// SetJSON replaces the text view's content with the given JSON document,
+// indented and highlighted with the colors of JSONStyles. Dynamic colors are
+// turned on for this purpose. If the document is not valid JSON, the raw text
+// is shown below an error indicator.
+func (t *TextView) SetJSON(document []byte) *TextView {
+	t.SetDynamicColors(true)
+	return t.SetText(formatJSON(document, JSONStyles))
+}
+
+// formatJSON returns the indented JSON document with color tags. Invalid
+// documents are returned unchanged (but escaped), preceded by an error line.
+func formatJSON(document []byte, theme JSONTheme) string {
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, document, "", JSONIndent); err != nil {
+		return fmt.Sprintf("[#%06x::b]Invalid JSON: %s[-::-]\n%s", theme.ErrorColor.Hex(), Escape(err.Error()), Escape(string(document)))
+	}
+
+	var (
+		text   = indented.Bytes()
+		result strings.Builder
+	)
+	colored := func(color tcell.Color, token []byte) {
+		fmt.Fprintf(&result, "[#%06x]%s[-]", color.Hex(), Escape(string(token)))
+	}
+	for pos := 0; pos < len(text); {
+		ch := text[pos]
+		switch {
+		case ch == '"':
+			// Find the end of the string.
+			end := pos + 1
+			for end < len(text) && text[end] != '"' {
+				if text[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end++
+			color := theme.StringColor
+			if end < len(text) && text[end] == ':' {
+				color = theme.KeyColor
+			}
+			colored(color, text[pos:end])
+			pos = end
+		case ch == '-' || ch >= '0' && ch <= '9':
+			end := pos + 1
+			for end < len(text) && strings.IndexByte("0123456789+-.eE", text[end]) >= 0 {
+				end++
+			}
+			colored(theme.NumberColor, text[pos:end])
+			pos = end
+		case bytes.HasPrefix(text[pos:], []byte("true")):
+			colored(theme.BooleanColor, text[pos:pos+4])
+			pos += 4
+		case bytes.HasPrefix(text[pos:], []byte("false")):
+			colored(theme.BooleanColor, text[pos:pos+5])
+			pos += 5
+		case bytes.HasPrefix(text[pos:], []byte("null")):
+			colored(theme.NullColor, text[pos:pos+4])
+			pos += 4
+		case strings.IndexByte("{}[]:,", ch) >= 0:
+			colored(theme.PunctuationColor, text[pos:pos+1])
+			pos++
+		default:
+			result.WriteByte(ch)
+			pos++
+		}
+	}
+	return result.String()
+}
@@ -0,0 +1,186 @@
+package tview
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// KeyBinding is one entry of a KeyMap: a key, the action it triggers, and a
+// description of that action for help screens.
+type KeyBinding struct {
+	ChordKey
+
+	// The group this binding belongs to, e.g. "Navigation".
+	Category string
+
+	// A short description of the action, e.g. "Scroll to the top".
+	Description string
+
+	// The function which is invoked when the key is pressed. May be nil for
+	// bindings which are handled elsewhere and only documented here.
+	Action func()
+}
+
+// Name returns a human-readable name of the binding's key, e.g. "Ctrl-S",
+// "Alt-x", "?", or "Space".
+func (k *KeyBinding) Name() string {
+	var name strings.Builder
+	mod := k.Mod
+	if k.Key != tcell.KeyRune {
+		if n, ok := tcell.KeyNames[k.Key]; ok {
+			if strings.HasPrefix(n, "Ctrl-") {
+				mod &^= tcell.ModCtrl
+			}
+			return modifierNames(mod) + n
+		}
+	}
+	name.WriteString(modifierNames(mod))
+	if k.Rune == ' ' {
+		name.WriteString("Space")
+	} else {
+		name.WriteRune(k.Rune)
+	}
+	return name.String()
+}
+
+// modifierNames returns the names of the given modifiers, each followed by a
+// dash.
+func modifierNames(mod tcell.ModMask) string {
+	var names string
+	if mod&tcell.ModCtrl != 0 {
+		names += "Ctrl-"
+	}
+	if mod&tcell.ModAlt != 0 {
+		names += "Alt-"
+	}
+	if mod&tcell.ModMeta != 0 {
+		names += "Meta-"
+	}
+	if mod&tcell.ModShift != 0 {
+		names += "Shift-"
+	}
+	return names
+}
+
+// KeyMap is a central registry of key bindings. Its Capture() function invokes
+// the actions of matching bindings and may be installed as an input capture:
+//
+//	keys := tview.NewKeyMap().
+//		BindRune('q', tcell.ModNone, "General", "Quit", app.Stop).
+//		BindKey(tcell.KeyCtrlS, tcell.ModCtrl, "File", "Save", save)
+//	app.SetInputCapture(keys.Capture)
+//
+// Because each binding carries a category and a description, a KeyMap can also
+// generate its own help screen, see HelpView.
+type KeyMap struct {
+	sync.Mutex
+
+	// The bindings in the order they were added.
+	bindings []*KeyBinding
+}
+
+// NewKeyMap returns a new, empty key map.
+func NewKeyMap() *KeyMap {
+	return &KeyMap{}
+}
+
+// BindKey adds a binding for a special key (anything other than tcell.KeyRune).
+// Note that tcell reports control keys such as tcell.KeyCtrlS with the
+// tcell.ModCtrl modifier.
+func (m *KeyMap) BindKey(key tcell.Key, mod tcell.ModMask, category, description string, action func()) *KeyMap {
+	return m.Add(&KeyBinding{
+		ChordKey:    ChordKey{Key: key, Mod: mod},
+		Category:    category,
+		Description: description,
+		Action:      action,
+	})
+}
+
+// BindRune adds a binding for a character key.
+func (m *KeyMap) BindRune(r rune, mod tcell.ModMask, category, description string, action func()) *KeyMap {
+	return m.Add(&KeyBinding{
+		ChordKey:    ChordKey{Key: tcell.KeyRune, Rune: r, Mod: mod},
+		Category:    category,
+		Description: description,
+		Action:      action,
+	})
+}
+
+// Add adds the given binding.
+func (m *KeyMap) Add(binding *KeyBinding) *KeyMap {
+	m.Lock()
+	defer m.Unlock()
+	m.bindings = append(m.bindings, binding)
+	return m
+}
+
+// Remove removes all bindings of the given key.
+func (m *KeyMap) Remove(key ChordKey) *KeyMap {
+	m.Lock()
+	defer m.Unlock()
+	bindings := m.bindings[:0]
+	for _, binding := range m.bindings {
+		if binding.ChordKey != key {
+			bindings = append(bindings, binding)
+		}
+	}
+	m.bindings = bindings
+	return m
+}
+
+// Clear removes all bindings.
+func (m *KeyMap) Clear() *KeyMap {
+	m.Lock()
+	defer m.Unlock()
+	m.bindings = nil
+	return m
+}
+
+// GetBindings returns a copy of all bindings in the order they were added.
+func (m *KeyMap) GetBindings() []*KeyBinding {
+	m.Lock()
+	defer m.Unlock()
+	return append([]*KeyBinding(nil), m.bindings...)
+}
+
+// GetCategories returns the categories of all bindings in the order in which
+// they first appear.
+func (m *KeyMap) GetCategories() []string {
+	m.Lock()
+	defer m.Unlock()
+	var categories []string
+	seen := make(map[string]bool)
+	for _, binding := range m.bindings {
+		if !seen[binding.Category] {
+			seen[binding.Category] = true
+			categories = append(categories, binding.Category)
+		}
+	}
+	return categories
+}
+
+// Capture invokes the action of the first binding which matches the given key
+// event and returns nil. If no binding with an action matches, the event is
+// returned unchanged. Its signature matches that of input capture functions.
+func (m *KeyMap) Capture(event *tcell.EventKey) *tcell.EventKey {
+	if event == nil {
+		return nil
+	}
+	m.Lock()
+	var action func()
+	for _, binding := range m.bindings {
+		if binding.Action != nil && binding.matches(event) {
+			action = binding.Action
+			break
+		}
+	}
+	m.Unlock()
+
+	if action == nil {
+		return event
+	}
+	action()
+	return nil
+}
@@ -24,8 +24,8 @@ type List struct {
 	// The items of the list.
 	items []*listItem
 
-	// The index of the currently selected item.
-	currentItem int
+	// The selected items. Its current index is the currently selected item.
+	selection *SelectionModel
 
 	// Whether or not to show the secondary item texts.
 	showSecondaryText bool
@@ -74,12 +74,24 @@ type List struct {
 
 	// An optional function which is called when the user presses the Escape key.
 	done func()
+
+	// Whether or not items can be dragged out of this list.
+	draggable bool
+
+	// An optional function which is called when an item is dropped onto this
+	// list. If set, the list is a drop target.
+	drop func(payload any, index int) bool
+
+	// An optional function which is called when an item dragged out of this
+	// list was dropped.
+	dragEnd func(payload *ListItemPayload, accepted bool)
 }
 
 // NewList returns a new list.
 func NewList() *List {
 	return &List{
 		Box:                NewBox(),
+		selection:          NewSelectionModel(SelectionSingle),
 		showSecondaryText:  true,
 		wrapAround:         true,
 		mainTextStyle:      tcell.StyleDefault.Foreground(Styles.PrimaryTextColor),
@@ -106,12 +118,12 @@ func (l *List) SetCurrentItem(index int) *List {
 		index = 0
 	}
 
-	if index != l.currentItem && l.changed != nil {
+	if index != l.selection.GetCurrent() && l.changed != nil {
 		item := l.items[index]
 		l.changed(index, item.MainText, item.SecondaryText, item.Shortcut)
 	}
 
-	l.currentItem = index
+	l.selection.Select(index, false, false)
 
 	l.adjustOffset()
 
@@ -121,7 +133,34 @@ func (l *List) SetCurrentItem(index int) *List {
 // GetCurrentItem returns the index of the currently selected list item,
 // starting at 0 for the first item.
 func (l *List) GetCurrentItem() int {
-	return l.currentItem
+	return l.selection.GetCurrent()
+}
+
+// SetSelectionMode sets how many items can be selected at once: one of
+// SelectionSingle (the default), SelectionMulti, or SelectionRange. In the
+// latter two modes, Shift combined with the navigation keys or a mouse click
+// extends the selection from the anchor item, and in SelectionMulti mode,
+// Ctrl-click toggles individual items.
+func (l *List) SetSelectionMode(mode int) *List {
+	l.selection.SetMode(mode)
+	return l
+}
+
+// GetSelectionModel returns the model holding the list's selected items.
+func (l *List) GetSelectionModel() *SelectionModel {
+	return l.selection
+}
+
+// GetSelection returns the indices of all selected items in ascending order.
+// In SelectionSingle mode, this is just the current item.
+func (l *List) GetSelection() []int {
+	if len(l.items) == 0 {
+		return nil
+	}
+	if l.selection.GetMode() == SelectionSingle {
+		return []int{l.selection.GetCurrent()}
+	}
+	return l.selection.GetSelection()
 }
 
 // SetOffset sets the number of items to be skipped (vertically) as well as the
@@ -174,19 +213,19 @@ func (l *List) RemoveItem(index int) *List {
 
 	// If there is nothing left, we're done.
 	if len(l.items) == 0 {
+		l.selection.Clear()
 		return l
 	}
 
 	// Shift current item.
-	previousCurrentItem := l.currentItem
-	if l.currentItem > index || l.currentItem == len(l.items) {
-		l.currentItem--
-	}
+	previousCurrentItem := l.selection.GetCurrent()
+	l.selection.Removed(index, len(l.items))
 
 	// Fire "changed" event for removed items.
 	if previousCurrentItem == index && l.changed != nil {
-		item := l.items[l.currentItem]
-		l.changed(l.currentItem, item.MainText, item.SecondaryText, item.Shortcut)
+		currentItem := l.selection.GetCurrent()
+		item := l.items[currentItem]
+		l.changed(currentItem, item.MainText, item.SecondaryText, item.Shortcut)
 	}
 
 	return l
@@ -363,8 +402,8 @@ func (l *List) InsertItem(index int, mainText, secondaryText string, shortcut ru
 	}
 
 	// Shift current item.
-	if l.currentItem < len(l.items) && l.currentItem >= index {
-		l.currentItem++
+	if len(l.items) > 0 {
+		l.selection.Inserted(index)
 	}
 
 	// Insert item (make space for the new item, then shift and insert).
@@ -446,13 +485,15 @@ func (l *List) FindItems(mainSearch, secondarySearch string, mustContainBoth, ig
 // Clear removes all items from the list.
 func (l *List) Clear() *List {
 	l.items = nil
-	l.currentItem = 0
+	l.selection.Clear()
 	return l
 }
 
 // Draw draws this primitive onto the screen.
 func (l *List) Draw(screen tcell.Screen) {
-	l.Box.DrawForSubclass(screen, l)
+	if !l.Box.DrawForSubclass(screen, l) {
+		return
+	}
 
 	// Determine the dimensions.
 	x, y, width, height := l.GetInnerRect()
@@ -506,7 +547,8 @@ func (l *List) Draw(screen tcell.Screen) {
 		}
 
 		// Background color of selected text.
-		if index == l.currentItem && (!l.selectedFocusOnly || l.HasFocus()) {
+		selected := index == l.selection.GetCurrent() || l.selection.GetMode() != SelectionSingle && l.selection.IsSelected(index)
+		if selected && (!l.selectedFocusOnly || l.HasFocus()) {
 			textWidth := width
 			if !l.highlightFullLine {
 				if w := TaggedStringWidth(item.MainText); w < textWidth {
@@ -562,15 +604,16 @@ func (l *List) adjustOffset() {
 	if height == 0 {
 		return
 	}
-	if l.currentItem < l.itemOffset {
-		l.itemOffset = l.currentItem
+	currentItem := l.selection.GetCurrent()
+	if currentItem < l.itemOffset {
+		l.itemOffset = currentItem
 	} else if l.showSecondaryText {
-		if 2*(l.currentItem-l.itemOffset) >= height-1 {
-			l.itemOffset = (2*l.currentItem + 3 - height) / 2
+		if 2*(currentItem-l.itemOffset) >= height-1 {
+			l.itemOffset = (2*currentItem + 3 - height) / 2
 		}
 	} else {
-		if l.currentItem-l.itemOffset >= height {
-			l.itemOffset = l.currentItem + 1 - height
+		if currentItem-l.itemOffset >= height {
+			l.itemOffset = currentItem + 1 - height
 		}
 	}
 }
@@ -587,49 +630,50 @@ func (l *List) InputHandler() func(event *tcell.EventKey, setFocus func(p Primit
 			return
 		}
 
-		previousItem := l.currentItem
+		currentItem := l.selection.GetCurrent()
+		previousItem := currentItem
 
 		switch key := event.Key(); key {
 		case tcell.KeyTab, tcell.KeyDown:
-			l.currentItem++
+			currentItem++
 		case tcell.KeyBacktab, tcell.KeyUp:
-			l.currentItem--
+			currentItem--
 		case tcell.KeyRight:
 			if l.overflowing {
 				l.horizontalOffset += 2 // We shift by 2 to account for two-cell characters.
 			} else {
-				l.currentItem++
+				currentItem++
 			}
 		case tcell.KeyLeft:
 			if l.horizontalOffset > 0 {
 				l.horizontalOffset -= 2
 			} else {
-				l.currentItem--
+				currentItem--
 			}
 		case tcell.KeyHome:
-			l.currentItem = 0
+			currentItem = 0
 		case tcell.KeyEnd:
-			l.currentItem = len(l.items) - 1
+			currentItem = len(l.items) - 1
 		case tcell.KeyPgDn:
 			_, _, _, height := l.GetInnerRect()
-			l.currentItem += height
-			if l.currentItem >= len(l.items) {
-				l.currentItem = len(l.items) - 1
+			currentItem += height
+			if currentItem >= len(l.items) {
+				currentItem = len(l.items) - 1
 			}
 		case tcell.KeyPgUp:
 			_, _, _, height := l.GetInnerRect()
-			l.currentItem -= height
-			if l.currentItem < 0 {
-				l.currentItem = 0
+			currentItem -= height
+			if currentItem < 0 {
+				currentItem = 0
 			}
 		case tcell.KeyEnter:
-			if l.currentItem >= 0 && l.currentItem < len(l.items) {
-				item := l.items[l.currentItem]
+			if currentItem >= 0 && currentItem < len(l.items) {
+				item := l.items[currentItem]
 				if item.Selected != nil {
 					item.Selected()
 				}
 				if l.selected != nil {
-					l.selected(l.currentItem, item.MainText, item.SecondaryText, item.Shortcut)
+					l.selected(currentItem, item.MainText, item.SecondaryText, item.Shortcut)
 				}
 			}
 		case tcell.KeyRune:
@@ -641,7 +685,8 @@ func (l *List) InputHandler() func(event *tcell.EventKey, setFocus func(p Primit
 					if item.Shortcut == ch {
 						// We have a shortcut.
 						found = true
-						l.currentItem = index
+						currentItem = index
+						l.selection.Select(index, false, false)
 						break
 					}
 				}
@@ -649,33 +694,36 @@ func (l *List) InputHandler() func(event *tcell.EventKey, setFocus func(p Primit
 					break
 				}
 			}
-			item := l.items[l.currentItem]
+			item := l.items[currentItem]
 			if item.Selected != nil {
 				item.Selected()
 			}
 			if l.selected != nil {
-				l.selected(l.currentItem, item.MainText, item.SecondaryText, item.Shortcut)
+				l.selected(currentItem, item.MainText, item.SecondaryText, item.Shortcut)
 			}
 		}
 
-		if l.currentItem < 0 {
+		if currentItem < 0 {
 			if l.wrapAround {
-				l.currentItem = len(l.items) - 1
+				currentItem = len(l.items) - 1
 			} else {
-				l.currentItem = 0
+				currentItem = 0
 			}
-		} else if l.currentItem >= len(l.items) {
+		} else if currentItem >= len(l.items) {
 			if l.wrapAround {
-				l.currentItem = 0
+				currentItem = 0
 			} else {
-				l.currentItem = len(l.items) - 1
+				currentItem = len(l.items) - 1
 			}
 		}
 
-		if l.currentItem != previousItem && l.currentItem < len(l.items) {
+		if currentItem != previousItem && currentItem < len(l.items) {
+			key := event.Key()
+			extend := event.Modifiers()&tcell.ModShift != 0 && key != tcell.KeyBacktab && key != tcell.KeyRune
+			l.selection.Select(currentItem, extend, false)
 			if l.changed != nil {
-				item := l.items[l.currentItem]
-				l.changed(l.currentItem, item.MainText, item.SecondaryText, item.Shortcut)
+				item := l.items[currentItem]
+				l.changed(currentItem, item.MainText, item.SecondaryText, item.Shortcut)
 			}
 			l.adjustOffset()
 		}
@@ -722,13 +770,13 @@ func (l *List) MouseHandler() func(action MouseAction, event *tcell.EventMouse,
 				if l.selected != nil {
 					l.selected(index, item.MainText, item.SecondaryText, item.Shortcut)
 				}
-				if index != l.currentItem {
+				if index != l.selection.GetCurrent() {
 					if l.changed != nil {
 						l.changed(index, item.MainText, item.SecondaryText, item.Shortcut)
 					}
 					l.adjustOffset()
 				}
-				l.currentItem = index
+				l.selection.SelectWithModifiers(index, event.Modifiers())
 			}
 			consumed = true
 		case MouseScrollUp:
@@ -750,3 +798,79 @@ func (l *List) MouseHandler() func(action MouseAction, event *tcell.EventMouse,
 		return
 	})
 }
+
+// SetDraggable sets whether or not items can be dragged out of this list with
+// the mouse. The payload of a dragged item is a *ListItemPayload. See
+// SetDragEndFunc() for being notified when a drag ends.
+func (l *List) SetDraggable(draggable bool) *List {
+	l.draggable = draggable
+	return l
+}
+
+// SetDragEndFunc sets a handler which is called when an item dragged out of
+// this list was released, with the information whether a drop target accepted
+// it. This can be used to remove moved items from the list.
+func (l *List) SetDragEndFunc(handler func(payload *ListItemPayload, accepted bool)) *List {
+	l.dragEnd = handler
+	return l
+}
+
+// SetDropFunc makes this list a drop target. The handler is called when dragged
+// content is dropped onto the list. It receives the payload (a
+// *ListItemPayload if it was dragged from a List) and the index of the item
+// under the mouse pointer, or the number of items if the content was dropped
+// below the last item. It returns whether the payload was accepted. Provide nil
+// to stop accepting drops.
+//
+//	target.SetDropFunc(func(payload any, index int) bool {
+//		item, ok := payload.(*tview.ListItemPayload)
+//		if ok {
+//			target.InsertItem(index, item.MainText, item.SecondaryText, item.Shortcut, nil)
+//		}
+//		return ok
+//	})
+func (l *List) SetDropFunc(handler func(payload any, index int) bool) *List {
+	l.drop = handler
+	return l
+}
+
+// DragStart is called by the application when the user starts dragging with
+// the mouse. It implements DragSource.
+func (l *List) DragStart(x, y int) (payload any, ok bool) {
+	if !l.draggable {
+		return nil, false
+	}
+	index := l.indexAtPoint(x, y)
+	if index < 0 {
+		return nil, false
+	}
+	item := l.items[index]
+	return &ListItemPayload{
+		Source:        l,
+		Index:         index,
+		MainText:      item.MainText,
+		SecondaryText: item.SecondaryText,
+		Shortcut:      item.Shortcut,
+	}, true
+}
+
+// DragEnd is called by the application when a drag which started on this list
+// ended. It implements DragSource.
+func (l *List) DragEnd(payload any, accepted bool) {
+	if item, ok := payload.(*ListItemPayload); ok && l.dragEnd != nil {
+		l.dragEnd(item, accepted)
+	}
+}
+
+// Drop is called by the application when dragged content is dropped onto this
+// list. It implements DropTarget.
+func (l *List) Drop(source Primitive, payload any, x, y int) bool {
+	if l.drop == nil {
+		return false
+	}
+	index := l.indexAtPoint(x, y)
+	if index < 0 {
+		index = len(l.items)
+	}
+	return l.drop(payload, index)
+}
@@ -0,0 +1,52 @@
+package tview
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// TestListCurrentItem checks that the list's current item is the current
+// index of its selection model.
+func TestListCurrentItem(t *testing.T) {
+	list := NewList()
+	for _, text := range []string{"a", "b", "c", "d"} {
+		list.AddItem(text, "", 0, nil)
+	}
+	var changed []int
+	list.SetChangedFunc(func(index int, mainText, secondaryText string, shortcut rune) {
+		changed = append(changed, index)
+	})
+	key := func(key tcell.Key) {
+		list.InputHandler()(tcell.NewEventKey(key, 0, tcell.ModNone), func(p Primitive) {})
+	}
+	check := func(name string, expected int) {
+		t.Helper()
+		if current := list.GetCurrentItem(); current != expected {
+			t.Errorf("%s: current item is %d, expected %d", name, current, expected)
+		}
+		if current := list.GetSelectionModel().GetCurrent(); current != expected {
+			t.Errorf("%s: current index of the selection model is %d, expected %d", name, current, expected)
+		}
+	}
+
+	list.GetSelectionModel().Select(2, false, false)
+	check("selected in the model", 2)
+	key(tcell.KeyDown)
+	check("down", 3)
+	list.SetCurrentItem(1)
+	check("set", 1)
+	list.InsertItem(0, "new", "", 0, nil)
+	check("inserted before", 2)
+	list.RemoveItem(2)
+	check("removed current", 2)
+	list.RemoveItem(-1)
+	check("removed last", 2)
+	key(tcell.KeyHome)
+	check("home", 0)
+
+	if expected := []int{3, 1, 2, 0}; !reflect.DeepEqual(changed, expected) {
+		t.Errorf("changed events for %v, expected %v", changed, expected)
+	}
+}
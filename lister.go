@@ -550,7 +550,9 @@ func (l *Lister) Clear() *Lister {
 
 // Draw draws this primitive onto the screen.
 func (l *Lister) Draw(screen tcell.Screen) {
-	l.DrawForSubclass(screen, l)
+	if !l.DrawForSubclass(screen, l) {
+		return
+	}
 
 	// Determine the dimensions.
 	x, y, width, height := l.GetInnerRect()
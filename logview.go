@@ -0,0 +1,233 @@
+package tview
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Log levels of a LogView, from least to most severe.
+const (
+	LogDebug = iota
+	LogInfo
+	LogWarning
+	LogError
+)
+
+// logEntry is one line of a LogView.
+type logEntry struct {
+	level   int
+	time    time.Time
+	message string
+}
+
+// logLevel describes how log entries of one level are shown.
+type logLevel struct {
+	label string
+	color tcell.Color
+}
+
+// LogView shows the tail of a log, one entry per line, each with a timestamp
+// and its level, colored by level. Entries are added with Append(), which may
+// be called from any goroutine. Only the most recent entries are kept (see
+// SetMaxEntries()), and entries below the minimum level (see SetMinLevel())
+// are hidden.
+//
+// While following (the default), the view scrolls to new entries. Scrolling
+// up stops following until the user scrolls back to the end or SetFollow() is
+// called.
+//
+// LogView is based on TextView, so wrapping and scrolling work the same way.
+// Don't write to the TextView directly as its text is replaced whenever the
+// entries change.
+type LogView struct {
+	*TextView
+
+	// Guards the entries, which are added from other goroutines.
+	mutex sync.Mutex
+
+	// The entries, a ring buffer starting at "first" and holding "count"
+	// entries.
+	entries      []logEntry
+	first, count int
+
+	// Whether the entries changed since the text was last rebuilt.
+	dirty bool
+
+	// The minimum level of shown entries.
+	minLevel int
+
+	// The labels and colors of the levels.
+	levels map[int]logLevel
+
+	// The layout of timestamps, see time.Time.Format(). No timestamps are
+	// shown if empty.
+	timeFormat string
+
+	// If set, the application is asked to redraw after new entries arrived.
+	app *Application
+}
+
+// NewLogView returns a new, empty log view keeping up to 1000 entries.
+func NewLogView() *LogView {
+	l := &LogView{
+		TextView:   NewTextView(),
+		entries:    make([]logEntry, 1000),
+		timeFormat: "15:04:05",
+		levels: map[int]logLevel{
+			LogDebug:   {"DBG", tcell.ColorGray},
+			LogInfo:    {"INF", Styles.PrimaryTextColor},
+			LogWarning: {"WRN", tcell.ColorYellow},
+			LogError:   {"ERR", tcell.ColorRed},
+		},
+	}
+	l.SetDynamicColors(true).SetScrollable(true).ScrollToEnd()
+	return l
+}
+
+// SetApplication sets the application which is asked to redraw the screen
+// whenever entries are appended. Without it, new entries appear with the next
+// redraw.
+func (l *LogView) SetApplication(app *Application) *LogView {
+	l.app = app
+	return l
+}
+
+// SetMaxEntries sets the maximum number of entries kept. When more entries
+// are appended, the oldest ones are dropped. Values below 1 are treated as 1.
+func (l *LogView) SetMaxEntries(max int) *LogView {
+	if max < 1 {
+		max = 1
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	entries := make([]logEntry, max)
+	skip := 0
+	if l.count > max {
+		skip = l.count - max
+	}
+	for index := skip; index < l.count; index++ {
+		entries[index-skip] = l.entries[(l.first+index)%len(l.entries)]
+	}
+	l.entries, l.first, l.count = entries, 0, l.count-skip
+	l.dirty = true
+	return l
+}
+
+// SetMinLevel hides all entries below the given level, e.g. LogInfo to hide
+// debug entries. Hidden entries are kept and shown again when the minimum
+// level is lowered.
+func (l *LogView) SetMinLevel(level int) *LogView {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.minLevel = level
+	l.dirty = true
+	return l
+}
+
+// GetMinLevel returns the minimum level of shown entries.
+func (l *LogView) GetMinLevel() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.minLevel
+}
+
+// SetLevelStyle sets the label and color of entries of the given level.
+func (l *LogView) SetLevelStyle(level int, label string, color tcell.Color) *LogView {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.levels[level] = logLevel{label: label, color: color}
+	l.dirty = true
+	return l
+}
+
+// SetTimeFormat sets the layout of the timestamps (see time.Time.Format()).
+// The default is "15:04:05". Provide an empty string to hide timestamps.
+func (l *LogView) SetTimeFormat(layout string) *LogView {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.timeFormat = layout
+	l.dirty = true
+	return l
+}
+
+// SetFollow sets whether the view scrolls to new entries.
+func (l *LogView) SetFollow(follow bool) *LogView {
+	if follow {
+		l.ScrollToEnd()
+	} else {
+		row, column := l.GetScrollOffset()
+		l.ScrollTo(row, column)
+	}
+	return l
+}
+
+// Append adds an entry with the given level and message, timestamped with the
+// current time. The message is shown as is, i.e. it may not contain color
+// tags. This function may be called from any goroutine.
+func (l *LogView) Append(level int, message string) *LogView {
+	l.mutex.Lock()
+	entry := logEntry{level: level, time: time.Now(), message: message}
+	if l.count < len(l.entries) {
+		l.entries[(l.first+l.count)%len(l.entries)] = entry
+		l.count++
+	} else {
+		l.entries[l.first] = entry
+		l.first = (l.first + 1) % len(l.entries)
+	}
+	l.dirty = true
+	app := l.app
+	l.mutex.Unlock()
+
+	if app != nil {
+		app.QueueUpdateDraw(func() {})
+	}
+	return l
+}
+
+// ClearEntries removes all entries.
+func (l *LogView) ClearEntries() *LogView {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.first, l.count = 0, 0
+	l.dirty = true
+	return l
+}
+
+// rebuild replaces the text view's text with the shown entries if they
+// changed.
+func (l *LogView) rebuild() {
+	l.mutex.Lock()
+	if !l.dirty {
+		l.mutex.Unlock()
+		return
+	}
+	var text strings.Builder
+	for index := 0; index < l.count; index++ {
+		entry := l.entries[(l.first+index)%len(l.entries)]
+		if entry.level < l.minLevel {
+			continue
+		}
+		level, ok := l.levels[entry.level]
+		if !ok {
+			level = logLevel{label: fmt.Sprintf("%3d", entry.level), color: Styles.PrimaryTextColor}
+		}
+		if l.timeFormat != "" {
+			fmt.Fprintf(&text, "[#%06x]%s[-] ", Styles.SecondaryTextColor.Hex(), Escape(entry.time.Format(l.timeFormat)))
+		}
+		fmt.Fprintf(&text, "[#%06x::b]%s[-::-] [#%06x]%s[-]\n", level.color.Hex(), Escape(level.label), level.color.Hex(), Escape(entry.message))
+	}
+	l.dirty = false
+	l.mutex.Unlock()
+
+	l.SetText(strings.TrimSuffix(text.String(), "\n"))
+}
+
+// Draw draws this primitive onto the screen.
+func (l *LogView) Draw(screen tcell.Screen) {
+	l.rebuild()
+	l.TextView.Draw(screen)
+}
@@ -143,6 +143,11 @@ func (m *Modal) HasFocus() bool {
 	return m.form.HasFocus()
 }
 
+// containedPrimitives returns the modal's frame. See primitiveContainer.
+func (m *Modal) containedPrimitives(visibleOnly bool) []Primitive {
+	return []Primitive{m.frame}
+}
+
 // Draw draws this primitive onto the screen.
 func (m *Modal) Draw(screen tcell.Screen) {
 	// Calculate the width of this modal.
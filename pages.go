@@ -304,6 +304,17 @@ func (p *Pages) HasFocus() bool {
 	return p.Box.HasFocus()
 }
 
+// containedPrimitives returns the pages in drawing order, omitting hidden
+// pages if visibleOnly is true. See primitiveContainer.
+func (p *Pages) containedPrimitives(visibleOnly bool) (children []Primitive) {
+	for _, page := range p.pages {
+		if page.Visible || !visibleOnly {
+			children = append(children, page.Item)
+		}
+	}
+	return
+}
+
 // Focus is called by the application when the primitive receives focus.
 func (p *Pages) Focus(delegate func(p Primitive)) {
 	if delegate == nil {
@@ -330,7 +341,9 @@ func (p *Pages) Focus(delegate func(p Primitive)) {
 
 // Draw draws this primitive onto the screen.
 func (p *Pages) Draw(screen tcell.Screen) {
-	p.Box.DrawForSubclass(screen, p)
+	if !p.Box.DrawForSubclass(screen, p) {
+		return
+	}
 	for _, page := range p.pages {
 		if !page.Visible {
 			continue
@@ -84,6 +84,46 @@ type Primitive interface {
 Event(f EventerFunc)
 }
 
+// Resizable may be implemented by primitives which want to be notified when
+// the terminal was resized, e.g. to recompute cached layouts once instead of
+// on every draw. ScreenResized() is called with the new screen size before the
+// screen is redrawn. Only primitives reachable from the application's root
+// through this package's containers (e.g. Flex, Grid, Pages, Form, Modal) are
+// notified.
+// Application.SetResizeDebounce() delays these notifications until resizing
+// has settled.
+type Resizable interface {
+	ScreenResized(width, height int)
+}
+
+// primitiveContainer is implemented by this package's primitives which contain
+// other primitives, e.g. Flex, Pages, or Form. It allows walking the tree of
+// primitives, e.g. to notify them of screen resizes or to find the primitive
+// at a mouse position.
+type primitiveContainer interface {
+	// containedPrimitives returns the direct children in drawing order. If
+	// visibleOnly is true, children which are currently not shown (e.g.
+	// hidden pages or collapsed content) are omitted.
+	containedPrimitives(visibleOnly bool) []Primitive
+}
+
+// PreferredSizer may be implemented by primitives which have a natural size,
+// e.g. the size of their content. It is used by wrappers such as Aligned to
+// size the primitive. A value of 0 means there is no preference for the
+// respective dimension.
+type PreferredSizer interface {
+	GetPreferredSize() (width, height int)
+}
+
+// Measurer may be implemented by primitives which can tell how much space
+// they want within the available space. Measure() receives the available
+// width and height and returns the desired size, which is never larger than
+// the available size. All primitives based on Box implement it, see
+// Box.SetMeasureFunc().
+type Measurer interface {
+	Measure(maxWidth, maxHeight int) (width, height int)
+}
+
 type AnimatedPrimitive interface {
   Primitive
   SetAnimating(bool)
@@ -107,8 +147,36 @@ type Paged interface {
   Changed(*Page, *Pages, PageChangeType)
 }
 
+// EventName identifies an event sent to an EventedFunc.
+type EventName string
+
+// Events sent by Box (and thus by all primitives) to their evented function,
+// see Box.SetEventedFunc().
+const (
+	// The rect changed. Arguments: x, y, width, height.
+	EventSetRect EventName = "set.rect"
+
+	// The size changed. Arguments: old width, old height, width, height.
+	EventResize EventName = "resize"
+
+	// The inner rect changed. Arguments: x, y, width, height.
+	EventInnerRect EventName = "inner.rect"
+
+	// The primitive received focus. No arguments.
+	EventFocus EventName = "focus"
+
+	// The primitive lost focus. No arguments.
+	EventBlur EventName = "blur"
+
+	// A slide-in transition finished. Arguments: the Edge.
+	EventSlideIn EventName = "slide.in"
+
+	// A slide-out transition finished. Arguments: the Edge.
+	EventSlideOut EventName = "slide.out"
+)
+
 type EventerFunc func(f EventedFunc)
-type EventedFunc func(event string, p Primitive, i ...any)
+type EventedFunc func(event EventName, p Primitive, i ...any)
 type InputHandlerFunc func(*tcell.EventKey, func(p Primitive)) 
 
 // FocusDirection decides in what direction the focus should travel relative
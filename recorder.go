@@ -0,0 +1,153 @@
+package tview
+
+import (
+	"sync"
+	"time"
+)
+
+// EventRecord is one event captured by an EventRecorder.
+type EventRecord struct {
+	// The name of the event, e.g. EventSetRect ("set.rect"). It is an
+	// EventName rather than a plain string so that it can be compared to the
+	// Event constants directly.
+	Name EventName
+
+	// The primitive which sent the event.
+	Source Primitive
+
+	// The event's arguments.
+	Args []interface{}
+
+	// The time at which the event was sent.
+	T time.Time
+}
+
+// EventRecorder captures the events which primitives send to their evented
+// function (see Box.SetEventedFunc()), e.g. to reproduce UI bugs. Attach it
+// to a primitive tree with Attach() or install the function returned by
+// Func() on individual primitives. The captured events can be retrieved with
+// Dump() and sent again with Replay().
+//
+// Attach() keeps the evented functions previously installed on the primitives
+// and forwards all events to them. All functions may be called from any
+// goroutine.
+type EventRecorder struct {
+	sync.Mutex
+
+	// The captured events, oldest first.
+	records []EventRecord
+
+	// The evented functions which were installed on the attached primitives
+	// before Attach() was called, nil for primitives which had none.
+	previous map[Primitive]EventedFunc
+
+	// Whether the recorder is currently replaying events, which are not
+	// captured again.
+	replaying bool
+}
+
+// NewEventRecorder returns a new, empty event recorder.
+func NewEventRecorder() *EventRecorder {
+	return &EventRecorder{}
+}
+
+// Func returns the evented function which captures events. Install it on
+// primitives with SetEventedFunc(). Unlike Attach(), this does not forward
+// events to any other function, and the events of such primitives are not
+// replayed.
+func (r *EventRecorder) Func() EventedFunc {
+	return r.forward(nil)
+}
+
+// forward returns an evented function which captures events and then passes
+// them on to the given function, if not nil.
+func (r *EventRecorder) forward(next EventedFunc) EventedFunc {
+	return func(event EventName, p Primitive, i ...any) {
+		r.Lock()
+		if !r.replaying {
+			r.records = append(r.records, EventRecord{
+				Name:   event,
+				Source: p,
+				Args:   append([]interface{}(nil), i...),
+				T:      time.Now(),
+			})
+		}
+		r.Unlock()
+		if next != nil {
+			next(event, p, i...)
+		}
+	}
+}
+
+// Attach installs the recorder's evented function on the given primitive and
+// all primitives contained in it, including hidden pages and collapsed
+// sections. Evented functions installed previously keep receiving all events.
+// Primitives added to the tree later need to be attached separately.
+// Attaching a primitive again has no effect.
+func (r *EventRecorder) Attach(root Primitive) *EventRecorder {
+	if root == nil {
+		return r
+	}
+	r.Lock()
+	if _, ok := r.previous[root]; !ok {
+		if r.previous == nil {
+			r.previous = make(map[Primitive]EventedFunc)
+		}
+		previous := root.GetEventedFunc()
+		r.previous[root] = previous
+		root.SetEventedFunc(r.forward(previous))
+	}
+	r.Unlock()
+	for _, child := range containerChildren(root, false) {
+		r.Attach(child)
+	}
+	return r
+}
+
+// Dump returns a copy of the captured events, oldest first.
+func (r *EventRecorder) Dump() []EventRecord {
+	r.Lock()
+	defer r.Unlock()
+	return append([]EventRecord(nil), r.records...)
+}
+
+// Clear removes all captured events.
+func (r *EventRecorder) Clear() *EventRecorder {
+	r.Lock()
+	defer r.Unlock()
+	r.records = nil
+	return r
+}
+
+// Replay sends the captured events again, keeping the time between them. Each
+// event is sent to the evented function its source primitive had before it
+// was attached (see Attach()). Events of primitives which were not attached
+// or had no evented function are skipped. The events are sent in the
+// application's main goroutine (see Application.QueueUpdateDraw()), so the
+// application must be running. Replay returns immediately; events sent while
+// replaying are not captured again.
+func (r *EventRecorder) Replay(app *Application) {
+	records := r.Dump()
+	go func() {
+		for index, record := range records {
+			if index > 0 {
+				time.Sleep(record.T.Sub(records[index-1].T))
+			}
+			record := record
+			app.QueueUpdateDraw(func() {
+				r.Lock()
+				previous := r.previous[record.Source]
+				if previous == nil {
+					r.Unlock()
+					return
+				}
+				r.replaying = true
+				r.Unlock()
+				previous(record.Name, record.Source, record.Args...)
+				r.Lock()
+				r.replaying = false
+				r.Unlock()
+			})
+		}
+	}()
+}
@@ -0,0 +1,63 @@
+package tview
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// TestEventRecorder checks that attached primitives keep sending events to
+// their previous evented functions and that replayed events are delivered to
+// them.
+func TestEventRecorder(t *testing.T) {
+	var forwarded []EventName
+	child := NewBox()
+	child.SetEventedFunc(func(event EventName, p Primitive, i ...any) {
+		if p != child {
+			t.Errorf("event %s forwarded from the wrong primitive", event)
+		}
+		forwarded = append(forwarded, event)
+	})
+	flex := NewFlex().AddItem(child, 0, 1, false)
+	recorder := NewEventRecorder().Attach(flex).Attach(flex)
+
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		t.Fatal(err)
+	}
+	defer screen.Fini()
+	flex.SetRect(0, 0, 20, 10)
+	flex.Draw(screen)
+
+	var recorded []EventName
+	for _, record := range recorder.Dump() {
+		if record.Source == child {
+			recorded = append(recorded, record.Name)
+		}
+	}
+	if len(recorded) == 0 {
+		t.Fatal("no events recorded")
+	}
+	if !reflect.DeepEqual(forwarded, recorded) {
+		t.Fatalf("forwarded %v, recorded %v", forwarded, recorded)
+	}
+
+	// Replay. Events of the flex are skipped as it had no evented function.
+	count := len(recorder.Dump())
+	forwarded = nil
+	app := NewApplication()
+	recorder.Replay(app)
+	deadline := time.Now().Add(time.Second)
+	for len(forwarded) < len(recorded) && time.Now().Before(deadline) {
+		runQueuedUpdates(app)
+		time.Sleep(time.Millisecond)
+	}
+	if !reflect.DeepEqual(forwarded, recorded) {
+		t.Errorf("replayed %v, expected %v", forwarded, recorded)
+	}
+	if len(recorder.Dump()) != count {
+		t.Errorf("replayed events were recorded again")
+	}
+}
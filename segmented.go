@@ -0,0 +1,226 @@
+package tview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// SegmentedControl shows a row of mutually exclusive options, such as
+// "Day | Week | Month", of which exactly one is selected. It is a compact
+// alternative to a group of radio buttons. The control is surrounded by a
+// border, with dividers between the segments.
+//
+// The selection is changed with the left and right arrow keys (or "h" and
+// "l"), Home and End, or by clicking a segment.
+type SegmentedControl struct {
+	*Box
+
+	// The segment labels.
+	segments []string
+
+	// The index of the selected segment, -1 if there are no segments.
+	selected int
+
+	// The styles of unselected and selected segments.
+	style, selectedStyle tcell.Style
+
+	// An optional function which is called when the user selects a different
+	// segment.
+	changed func(index int, label string)
+}
+
+// NewSegmentedControl returns a new segmented control without segments.
+func NewSegmentedControl() *SegmentedControl {
+	s := &SegmentedControl{
+		Box:           NewBox(),
+		selected:      -1,
+		style:         tcell.StyleDefault.Foreground(Styles.PrimaryTextColor).Background(Styles.PrimitiveBackgroundColor),
+		selectedStyle: tcell.StyleDefault.Foreground(Styles.PrimitiveBackgroundColor).Background(Styles.PrimaryTextColor),
+	}
+	s.SetBorder(true)
+	return s
+}
+
+// AddSegment appends a segment with the given label, which may contain color
+// tags. The first segment added is selected.
+func (s *SegmentedControl) AddSegment(label string) *SegmentedControl {
+	s.segments = append(s.segments, label)
+	if s.selected < 0 {
+		s.selected = 0
+	}
+	return s
+}
+
+// GetSegmentCount returns the number of segments.
+func (s *SegmentedControl) GetSegmentCount() int {
+	return len(s.segments)
+}
+
+// Clear removes all segments.
+func (s *SegmentedControl) Clear() *SegmentedControl {
+	s.segments = nil
+	s.selected = -1
+	return s
+}
+
+// SetSelected selects the segment with the given index. Out of range indices
+// are ignored. The "changed" callback is not invoked.
+func (s *SegmentedControl) SetSelected(index int) *SegmentedControl {
+	if index >= 0 && index < len(s.segments) {
+		s.selected = index
+	}
+	return s
+}
+
+// GetSelected returns the index of the selected segment, or -1 if there are no
+// segments.
+func (s *SegmentedControl) GetSelected() int {
+	return s.selected
+}
+
+// SetStyles sets the styles of unselected and selected segments.
+func (s *SegmentedControl) SetStyles(normal, selected tcell.Style) *SegmentedControl {
+	s.style, s.selectedStyle = normal, selected
+	return s
+}
+
+// SetChangedFunc sets a handler which is called when the user selects a
+// different segment.
+func (s *SegmentedControl) SetChangedFunc(handler func(index int, label string)) *SegmentedControl {
+	s.changed = handler
+	return s
+}
+
+// GetPreferredSize returns the size needed to show all segments.
+func (s *SegmentedControl) GetPreferredSize() (width, height int) {
+	for index, label := range s.segments {
+		if index > 0 {
+			width++ // Divider.
+		}
+		width += TaggedStringWidth(label) + 2
+	}
+	top, bottom, left, right := s.borderWidths()
+	width += s.paddingLeft + s.paddingRight + left + right
+	height = 1 + s.paddingTop + s.paddingBottom + top + bottom
+	return
+}
+
+// segmentAt returns the index of the segment at the given screen column or -1
+// if there is none.
+func (s *SegmentedControl) segmentAt(column int) int {
+	x, _, width, _ := s.GetInnerRect()
+	for index, label := range s.segments {
+		segmentWidth := TaggedStringWidth(label) + 2
+		if column >= x && column < x+segmentWidth && column < x+width {
+			return index
+		}
+		x += segmentWidth + 1
+	}
+	return -1
+}
+
+// Draw draws this primitive onto the screen.
+func (s *SegmentedControl) Draw(screen tcell.Screen) {
+	if !s.Box.DrawForSubclass(screen, s) {
+		return
+	}
+	x, y, width, height := s.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+	borders := s.borderStyles
+	if borders == nil {
+		borders = DefaultBorders
+	}
+	dividerStyle := s.style.Foreground(s.borderColor)
+	if s.HasFocus() {
+		dividerStyle = s.style.Foreground(s.borderFocusColor)
+	}
+
+	right := x + width
+	for index, label := range s.segments {
+		if x >= right {
+			break
+		}
+		if index > 0 {
+			// Divider, joined with the surrounding border.
+			for row := y; row < y+height; row++ {
+				screen.SetContent(x, row, borders.Vertical, nil, dividerStyle)
+			}
+			if s.border && s.borderTop && y-1 >= s.y {
+				screen.SetContent(x, y-1, borders.TopT, nil, dividerStyle)
+			}
+			if s.border && s.borderBottom && y+height < s.y+s.height {
+				screen.SetContent(x, y+height, borders.BottomT, nil, dividerStyle)
+			}
+			x++
+		}
+
+		// Segment.
+		style := s.style
+		if index == s.selected {
+			style = s.selectedStyle
+		}
+		segmentWidth := TaggedStringWidth(label) + 2
+		if x+segmentWidth > right {
+			segmentWidth = right - x
+		}
+		for column := x; column < x+segmentWidth; column++ {
+			for row := y; row < y+height; row++ {
+				screen.SetContent(column, row, ' ', nil, style)
+			}
+		}
+		printWithStyle(screen, label, x+1, y+(height-1)/2, 0, segmentWidth-1, AlignLeft, style, false)
+		x += segmentWidth
+	}
+}
+
+// selectSegment selects the segment with the given index and invokes the
+// "changed" callback if the selection changed.
+func (s *SegmentedControl) selectSegment(index int) {
+	if index < 0 || index >= len(s.segments) || index == s.selected {
+		return
+	}
+	s.selected = index
+	if s.changed != nil {
+		s.changed(index, s.segments[index])
+	}
+}
+
+// InputHandler returns the handler for this primitive.
+func (s *SegmentedControl) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
+	return s.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		switch key := event.Key(); key {
+		case tcell.KeyLeft:
+			s.selectSegment(s.selected - 1)
+		case tcell.KeyRight:
+			s.selectSegment(s.selected + 1)
+		case tcell.KeyHome:
+			s.selectSegment(0)
+		case tcell.KeyEnd:
+			s.selectSegment(len(s.segments) - 1)
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'h':
+				s.selectSegment(s.selected - 1)
+			case 'l':
+				s.selectSegment(s.selected + 1)
+			}
+		}
+	})
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (s *SegmentedControl) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+	return s.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		x, y := event.Position()
+		if !s.InRect(x, y) {
+			return false, nil
+		}
+		if action == MouseLeftClick {
+			setFocus(s)
+			s.selectSegment(s.segmentAt(x))
+			consumed = true
+		}
+		return
+	})
+}
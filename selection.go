@@ -0,0 +1,225 @@
+package tview
+
+import (
+	"sort"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Selection modes for SelectionModel.
+const (
+	SelectionSingle = iota // Only the current index is selected.
+	SelectionMulti         // Any set of indices may be selected.
+	SelectionRange         // A single contiguous range of indices is selected.
+)
+
+// SelectionModel keeps track of the selected indices of a list-like primitive
+// such as List or Table. It has a current index (the cursor), an anchor from
+// which ranges are extended, and the set of selected indices.
+//
+// In SelectionSingle mode, only the current index is selected. In
+// SelectionMulti mode, individual indices can be toggled and ranges added. In
+// SelectionRange mode, the selection is always the contiguous range between
+// the anchor and the current index.
+type SelectionModel struct {
+	// The selection mode, one of the Selection constants.
+	mode int
+
+	// The index ranges are extended from.
+	anchor int
+
+	// The current index.
+	current int
+
+	// The selected indices.
+	selected map[int]bool
+
+	// An optional function which is called when the selection has changed.
+	changed func(selection []int)
+}
+
+// NewSelectionModel returns a new selection model in the given mode, with
+// index 0 being current.
+func NewSelectionModel(mode int) *SelectionModel {
+	return &SelectionModel{
+		mode:     mode,
+		selected: map[int]bool{0: true},
+	}
+}
+
+// SetMode sets the selection mode, one of SelectionSingle, SelectionMulti, or
+// SelectionRange. The selection is reduced to the current index.
+func (s *SelectionModel) SetMode(mode int) *SelectionModel {
+	s.mode = mode
+	s.Select(s.current, false, false)
+	return s
+}
+
+// GetMode returns the selection mode.
+func (s *SelectionModel) GetMode() int {
+	return s.mode
+}
+
+// SetChangedFunc sets a handler which is called whenever the set of selected
+// indices changes.
+func (s *SelectionModel) SetChangedFunc(handler func(selection []int)) *SelectionModel {
+	s.changed = handler
+	return s
+}
+
+// Select makes the given index current. If extend is true and the mode is not
+// SelectionSingle, the range from the anchor to the index is selected (added to
+// the selection in SelectionMulti mode). If toggle is true and the mode is
+// SelectionMulti, the index's selection state is flipped and it becomes the
+// new anchor. Otherwise, only the given index is selected.
+func (s *SelectionModel) Select(index int, extend, toggle bool) *SelectionModel {
+	previous := s.GetSelection()
+	switch {
+	case extend && s.mode != SelectionSingle:
+		if s.mode == SelectionRange {
+			s.selected = make(map[int]bool)
+		}
+		from, to := s.anchor, index
+		if from > to {
+			from, to = to, from
+		}
+		for i := from; i <= to; i++ {
+			s.selected[i] = true
+		}
+	case toggle && s.mode == SelectionMulti:
+		if s.selected[index] {
+			delete(s.selected, index)
+		} else {
+			s.selected[index] = true
+		}
+		s.anchor = index
+	default:
+		s.selected = map[int]bool{index: true}
+		s.anchor = index
+	}
+	s.current = index
+	s.fireChanged(previous)
+	return s
+}
+
+// SelectWithModifiers calls Select() for the given index, extending the
+// selection if the Shift modifier is set and toggling it if the Ctrl or Meta
+// modifier is set. This is the usual mapping for mouse clicks and navigation
+// keys.
+func (s *SelectionModel) SelectWithModifiers(index int, mod tcell.ModMask) *SelectionModel {
+	return s.Select(index, mod&tcell.ModShift != 0, mod&(tcell.ModCtrl|tcell.ModMeta) != 0)
+}
+
+// SelectAll selects the indices 0 to count-1, keeping the current index. This
+// has no effect in SelectionSingle mode.
+func (s *SelectionModel) SelectAll(count int) *SelectionModel {
+	if s.mode == SelectionSingle || count <= 0 {
+		return s
+	}
+	previous := s.GetSelection()
+	s.selected = make(map[int]bool)
+	for i := 0; i < count; i++ {
+		s.selected[i] = true
+	}
+	s.anchor = 0
+	s.fireChanged(previous)
+	return s
+}
+
+// GetCurrent returns the current index.
+func (s *SelectionModel) GetCurrent() int {
+	return s.current
+}
+
+// GetAnchor returns the index ranges are extended from.
+func (s *SelectionModel) GetAnchor() int {
+	return s.anchor
+}
+
+// IsSelected returns whether the given index is selected.
+func (s *SelectionModel) IsSelected(index int) bool {
+	return s.selected[index]
+}
+
+// GetSelection returns the selected indices in ascending order.
+func (s *SelectionModel) GetSelection() []int {
+	selection := make([]int, 0, len(s.selected))
+	for index := range s.selected {
+		selection = append(selection, index)
+	}
+	sort.Ints(selection)
+	return selection
+}
+
+// Clear resets the selection to index 0 being current.
+func (s *SelectionModel) Clear() *SelectionModel {
+	previous := s.GetSelection()
+	s.anchor, s.current = 0, 0
+	s.selected = map[int]bool{0: true}
+	s.fireChanged(previous)
+	return s
+}
+
+// Inserted shifts all indices at or after the given index by one, to account
+// for an item which was inserted at that index.
+func (s *SelectionModel) Inserted(index int) *SelectionModel {
+	return s.shift(index, 1)
+}
+
+// Removed removes the given index from the selection and shifts all indices
+// after it back by one, to account for an item which was removed. count is
+// the number of items remaining; the current index is clamped to it.
+func (s *SelectionModel) Removed(index, count int) *SelectionModel {
+	previous := s.GetSelection()
+	delete(s.selected, index)
+	s.shift(index+1, -1)
+	if s.current >= count && count > 0 {
+		s.current = count - 1
+	}
+	if s.mode == SelectionSingle || len(s.selected) == 0 {
+		s.selected = map[int]bool{s.current: true}
+	}
+	s.fireChanged(previous)
+	return s
+}
+
+// shift moves all indices at or after "from" by "delta".
+func (s *SelectionModel) shift(from, delta int) *SelectionModel {
+	selected := make(map[int]bool, len(s.selected))
+	for index := range s.selected {
+		if index >= from {
+			index += delta
+		}
+		selected[index] = true
+	}
+	s.selected = selected
+	if s.anchor >= from {
+		s.anchor += delta
+	}
+	if s.current >= from {
+		s.current += delta
+	}
+	return s
+}
+
+// fireChanged calls the "changed" handler if the selection differs from the
+// previous one.
+func (s *SelectionModel) fireChanged(previous []int) {
+	if s.changed == nil {
+		return
+	}
+	current := s.GetSelection()
+	if len(current) == len(previous) {
+		same := true
+		for i := range current {
+			if current[i] != previous[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return
+		}
+	}
+	s.changed(current)
+}
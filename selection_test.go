@@ -0,0 +1,83 @@
+package tview
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// TestSelectionModel checks the selection, current index, and anchor of a
+// SelectionModel after a sequence of operations in each mode.
+func TestSelectionModel(t *testing.T) {
+	type step struct {
+		name      string
+		apply     func(s *SelectionModel)
+		selection []int
+		current   int
+		anchor    int // Not checked if negative.
+	}
+	tests := []struct {
+		mode  int
+		steps []step
+	}{
+		{SelectionSingle, []step{
+			{"select", func(s *SelectionModel) { s.Select(3, false, false) }, []int{3}, 3, 3},
+			{"extend", func(s *SelectionModel) { s.Select(5, true, false) }, []int{5}, 5, 5},
+			{"toggle", func(s *SelectionModel) { s.Select(2, false, true) }, []int{2}, 2, 2},
+			{"select all", func(s *SelectionModel) { s.SelectAll(4) }, []int{2}, 2, 2},
+		}},
+		{SelectionMulti, []step{
+			{"select", func(s *SelectionModel) { s.Select(1, false, false) }, []int{1}, 1, 1},
+			{"toggle on", func(s *SelectionModel) { s.Select(4, false, true) }, []int{1, 4}, 4, 4},
+			{"extend", func(s *SelectionModel) { s.Select(6, true, false) }, []int{1, 4, 5, 6}, 6, 4},
+			{"toggle off", func(s *SelectionModel) { s.Select(5, false, true) }, []int{1, 4, 6}, 5, 5},
+			{"shift modifier", func(s *SelectionModel) { s.SelectWithModifiers(3, tcell.ModShift) }, []int{1, 3, 4, 5, 6}, 3, 5},
+			{"ctrl modifier", func(s *SelectionModel) { s.SelectWithModifiers(1, tcell.ModCtrl) }, []int{3, 4, 5, 6}, 1, 1},
+			{"insert", func(s *SelectionModel) { s.Inserted(4) }, []int{3, 5, 6, 7}, 1, 1},
+			{"remove", func(s *SelectionModel) { s.Removed(3, 7) }, []int{4, 5, 6}, 1, 1},
+			{"remove current beyond end", func(s *SelectionModel) { s.Select(6, false, true).Removed(0, 4) }, []int{3, 4}, 3, -1},
+			{"select all", func(s *SelectionModel) { s.SelectAll(3) }, []int{0, 1, 2}, 3, 0},
+			{"clear", func(s *SelectionModel) { s.Clear() }, []int{0}, 0, 0},
+		}},
+		{SelectionRange, []step{
+			{"select", func(s *SelectionModel) { s.Select(2, false, false) }, []int{2}, 2, 2},
+			{"extend down", func(s *SelectionModel) { s.Select(4, true, false) }, []int{2, 3, 4}, 4, 2},
+			{"extend up", func(s *SelectionModel) { s.Select(0, true, false) }, []int{0, 1, 2}, 0, 2},
+			{"toggle", func(s *SelectionModel) { s.Select(5, false, true) }, []int{5}, 5, 5},
+			{"switch to single", func(s *SelectionModel) { s.Select(7, true, false).SetMode(SelectionSingle) }, []int{7}, 7, 7},
+		}},
+	}
+	for _, test := range tests {
+		s := NewSelectionModel(test.mode)
+		for _, step := range test.steps {
+			step.apply(s)
+			if selection := s.GetSelection(); !reflect.DeepEqual(selection, step.selection) {
+				t.Errorf("mode %d, %s: selection is %v, expected %v", test.mode, step.name, selection, step.selection)
+			}
+			if current := s.GetCurrent(); current != step.current {
+				t.Errorf("mode %d, %s: current index is %d, expected %d", test.mode, step.name, current, step.current)
+			}
+			if anchor := s.GetAnchor(); step.anchor >= 0 && anchor != step.anchor {
+				t.Errorf("mode %d, %s: anchor is %d, expected %d", test.mode, step.name, anchor, step.anchor)
+			}
+		}
+	}
+}
+
+// TestSelectionModelChanged checks that the "changed" handler is only called
+// when the set of selected indices changes.
+func TestSelectionModelChanged(t *testing.T) {
+	var changes [][]int
+	s := NewSelectionModel(SelectionMulti).SetChangedFunc(func(selection []int) {
+		changes = append(changes, selection)
+	})
+	s.Select(0, false, false) // Unchanged.
+	s.Select(2, false, true)
+	s.Select(2, true, false) // Unchanged.
+	s.Select(1, false, false)
+	expected := [][]int{{0, 2}, {1}}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("changes are %v, expected %v", changes, expected)
+	}
+}
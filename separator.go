@@ -0,0 +1,95 @@
+package tview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// Separator is a horizontal or vertical rule which visually separates
+// sections of a layout, e.g. items in a Flex. It fills its entire rectangle
+// with the box's border glyphs (see Box.SetBorderStyle()). A horizontal
+// separator may show a label centered on the line.
+type Separator struct {
+	*Box
+
+	// Whether the line runs from top to bottom instead of from left to right.
+	vertical bool
+
+	// The style of the line.
+	style tcell.Style
+
+	// An optional label centered on a horizontal line.
+	label string
+
+	// The style of the label.
+	labelStyle tcell.Style
+}
+
+// NewSeparator returns a new horizontal separator.
+func NewSeparator() *Separator {
+	return &Separator{
+		Box:        NewBox(),
+		style:      tcell.StyleDefault.Foreground(Styles.BorderColor).Background(Styles.PrimitiveBackgroundColor),
+		labelStyle: tcell.StyleDefault.Foreground(Styles.TitleColor).Background(Styles.PrimitiveBackgroundColor),
+	}
+}
+
+// SetOrientation sets whether the separator is a vertical line (true) or a
+// horizontal line (false, the default).
+func (s *Separator) SetOrientation(vertical bool) *Separator {
+	s.vertical = vertical
+	return s
+}
+
+// SetStyle sets the style of the line.
+func (s *Separator) SetStyle(style tcell.Style) *Separator {
+	s.style = style
+	return s
+}
+
+// SetLabel sets a text which is shown centered on a horizontal separator. It
+// may contain color tags. The label is not shown on vertical separators.
+func (s *Separator) SetLabel(label string) *Separator {
+	s.label = label
+	return s
+}
+
+// SetLabelStyle sets the style of the label.
+func (s *Separator) SetLabelStyle(style tcell.Style) *Separator {
+	s.labelStyle = style
+	return s
+}
+
+// Draw draws this primitive onto the screen.
+func (s *Separator) Draw(screen tcell.Screen) {
+	if !s.Box.DrawForSubclass(screen, s) {
+		return
+	}
+	x, y, width, height := s.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+	borders := s.borderStyles
+	if borders == nil {
+		borders = DefaultBorders
+	}
+
+	if s.vertical {
+		for row := y; row < y+height; row++ {
+			screen.SetContent(x+width/2, row, borders.Vertical, nil, s.style)
+		}
+		return
+	}
+
+	line := y + height/2
+	for column := x; column < x+width; column++ {
+		screen.SetContent(column, line, borders.Horizontal, nil, s.style)
+	}
+	if s.label != "" && width > 4 {
+		label := " " + s.label + " "
+		labelWidth := TaggedStringWidth(label)
+		if labelWidth > width-2 {
+			labelWidth = width - 2
+		}
+		printWithStyle(screen, label, x+(width-labelWidth)/2, line, 0, labelWidth, AlignLeft, s.labelStyle, true)
+	}
+}
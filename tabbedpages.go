@@ -0,0 +1,379 @@
+package tview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// TabCloseGlyph is the rune drawn on a tab which, when clicked, closes it.
+var TabCloseGlyph = '×'
+
+// tabSpan holds the screen position of one tab in the tab bar as determined
+// the last time Draw() was called.
+type tabSpan struct {
+	from, to int // The first and one-past-the-last column of the tab.
+	closeX   int // The column of the close glyph, -1 if it isn't drawn.
+}
+
+// TabbedPages is a Pages container with a tab bar on top. Each tab shows the
+// title of its page. Clicking a tab switches to its page. Tabs carry a close
+// glyph (see TabCloseGlyph) which, when clicked, closes the tab. The active tab
+// can also be closed with Ctrl-W. After a tab was closed, the adjacent tab
+// becomes active.
+//
+// Tab titles double as page names and must therefore be unique.
+type TabbedPages struct {
+	*Box
+
+	// The pages holding the tab contents.
+	pages *Pages
+
+	// The tab titles in the order they are shown.
+	tabs []string
+
+	// The index of the active tab.
+	current int
+
+	// Whether or not tabs may be closed by the user.
+	closable bool
+
+	// If true, the close glyph is only shown on the tab under the mouse
+	// pointer. If false, it is shown on every tab.
+	closeOnHover bool
+
+	// The index of the tab under the mouse pointer, -1 if there is none.
+	hoverTab int
+
+	// The style of inactive tabs.
+	tabStyle tcell.Style
+
+	// The style of the active tab.
+	activeTabStyle tcell.Style
+
+	// The tab positions as of the last call to Draw().
+	spans []tabSpan
+
+	// The y coordinate of the tab bar as of the last call to Draw().
+	barY int
+
+	// An optional function which is called before a tab is closed by the user.
+	// Returning false vetoes the close.
+	closed func(title string) bool
+
+	// An optional function which is called when the active tab changes.
+	changed func(title string)
+}
+
+// NewTabbedPages returns a new, empty tabbed pages container.
+func NewTabbedPages() *TabbedPages {
+	t := &TabbedPages{
+		Box:            NewBox(),
+		pages:          NewPages(),
+		closable:       true,
+		hoverTab:       -1,
+		tabStyle:       tcell.StyleDefault.Foreground(Styles.SecondaryTextColor).Background(Styles.ContrastBackgroundColor),
+		activeTabStyle: tcell.StyleDefault.Foreground(Styles.InverseTextColor).Background(Styles.PrimaryTextColor),
+	}
+	t.pages.SetParent(t)
+	return t
+}
+
+// SetTabStyles sets the styles of inactive and active tabs.
+func (t *TabbedPages) SetTabStyles(inactive, active tcell.Style) *TabbedPages {
+	t.tabStyle = inactive
+	t.activeTabStyle = active
+	return t
+}
+
+// SetClosable sets whether or not the user may close tabs, either by clicking
+// the close glyph or by pressing Ctrl-W.
+func (t *TabbedPages) SetClosable(closable bool) *TabbedPages {
+	t.closable = closable
+	return t
+}
+
+// SetCloseOnHover sets whether the close glyph is shown only on the tab under
+// the mouse pointer (true) or on all tabs (false, the default). The active
+// tab's close glyph is also hidden in hover mode, Ctrl-W still closes it.
+func (t *TabbedPages) SetCloseOnHover(hover bool) *TabbedPages {
+	t.closeOnHover = hover
+	return t
+}
+
+// SetTabClosedFunc sets a handler which is called when the user closes a tab.
+// It receives the tab's title. If it returns false, the tab remains open.
+func (t *TabbedPages) SetTabClosedFunc(handler func(title string) bool) *TabbedPages {
+	t.closed = handler
+	return t
+}
+
+// SetChangedFunc sets a handler which is called when the active tab changes.
+// It receives the title of the new active tab or an empty string if the last
+// tab was closed.
+func (t *TabbedPages) SetChangedFunc(handler func(title string)) *TabbedPages {
+	t.changed = handler
+	return t
+}
+
+// AddTab adds a new tab with the given title and content to the end of the tab
+// bar. If a tab with the same title exists, its content is replaced. The first
+// tab added becomes the active tab.
+func (t *TabbedPages) AddTab(title string, item Primitive) *TabbedPages {
+	for index, tab := range t.tabs {
+		if tab == title {
+			t.pages.AddPage(title, item, true, index == t.current)
+			return t
+		}
+	}
+	t.tabs = append(t.tabs, title)
+	t.pages.AddPage(title, item, true, len(t.tabs)-1 == t.current)
+	if len(t.tabs) == 1 && t.changed != nil {
+		t.changed(title)
+	}
+	return t
+}
+
+// RemoveTab removes the tab with the given title without consulting the
+// handler set with SetTabClosedFunc(). If it was the active tab, the adjacent
+// tab becomes active.
+func (t *TabbedPages) RemoveTab(title string) *TabbedPages {
+	for index, tab := range t.tabs {
+		if tab == title {
+			t.removeTab(index)
+			break
+		}
+	}
+	return t
+}
+
+// GetTabCount returns the number of tabs.
+func (t *TabbedPages) GetTabCount() int {
+	return len(t.tabs)
+}
+
+// SetCurrentTab makes the tab with the given title the active tab.
+func (t *TabbedPages) SetCurrentTab(title string) *TabbedPages {
+	for index, tab := range t.tabs {
+		if tab == title {
+			t.switchTo(index)
+			break
+		}
+	}
+	return t
+}
+
+// GetCurrentTab returns the title and content of the active tab. If there are
+// no tabs, ("", nil) is returned.
+func (t *TabbedPages) GetCurrentTab() (title string, item Primitive) {
+	if t.current < 0 || t.current >= len(t.tabs) {
+		return
+	}
+	title = t.tabs[t.current]
+	if page := t.pages.GetPage(title); page != nil {
+		item = page.Item
+	}
+	return
+}
+
+// switchTo makes the tab with the given index the active tab.
+func (t *TabbedPages) switchTo(index int) {
+	if index < 0 || index >= len(t.tabs) {
+		return
+	}
+	changed := index != t.current
+	t.current = index
+	t.pages.SwitchToPage(t.tabs[index])
+	if changed && t.changed != nil {
+		t.changed(t.tabs[index])
+	}
+}
+
+// removeTab removes the tab with the given index and activates the adjacent
+// tab if the removed tab was the active one.
+func (t *TabbedPages) removeTab(index int) {
+	title := t.tabs[index]
+	t.tabs = append(t.tabs[:index], t.tabs[index+1:]...)
+	t.pages.RemovePage(title)
+	t.hoverTab = -1
+
+	if len(t.tabs) == 0 {
+		t.current = 0
+		if t.changed != nil {
+			t.changed("")
+		}
+		return
+	}
+
+	switch {
+	case index < t.current:
+		t.current--
+	case index == t.current:
+		// Prefer the tab to the right, fall back to the one on the left.
+		if t.current >= len(t.tabs) {
+			t.current = len(t.tabs) - 1
+		}
+		t.pages.SwitchToPage(t.tabs[t.current])
+		if t.changed != nil {
+			t.changed(t.tabs[t.current])
+		}
+	}
+}
+
+// closeTab closes the tab with the given index if the close handler allows it
+// and moves the focus to the new active tab if the container had focus.
+func (t *TabbedPages) closeTab(index int, setFocus func(p Primitive)) {
+	if !t.closable || index < 0 || index >= len(t.tabs) {
+		return
+	}
+	if t.closed != nil && !t.closed(t.tabs[index]) {
+		return
+	}
+	hasFocus := t.HasFocus()
+	t.removeTab(index)
+	if hasFocus && setFocus != nil {
+		if _, item := t.GetCurrentTab(); item != nil {
+			setFocus(item)
+		} else {
+			setFocus(t)
+		}
+	}
+}
+
+// Draw draws this primitive onto the screen.
+func (t *TabbedPages) Draw(screen tcell.Screen) {
+	if !t.Box.DrawForSubclass(screen, t) {
+		return
+	}
+
+	x, y, width, height := t.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+	t.barY = y
+
+	// Draw the tab bar.
+	t.spans = t.spans[:0]
+	pos := x
+	for index, title := range t.tabs {
+		if pos >= x+width {
+			break
+		}
+		style := t.tabStyle
+		if index == t.current {
+			style = t.activeTabStyle
+		}
+		showClose := t.closable && (!t.closeOnHover || index == t.hoverTab)
+
+		span := tabSpan{from: pos, closeX: -1}
+		_, printed, _, _ := printWithStyle(screen, " "+title+" ", pos, y, 0, x+width-pos, AlignLeft, style, false)
+		pos += printed
+		if showClose && pos < x+width {
+			screen.SetContent(pos, y, TabCloseGlyph, nil, style)
+			span.closeX = pos
+			pos++
+			if pos < x+width {
+				screen.SetContent(pos, y, ' ', nil, style)
+				pos++
+			}
+		}
+		span.to = pos
+		t.spans = append(t.spans, span)
+
+		// Separate tabs by one cell.
+		pos++
+	}
+
+	// Draw the active page below the tab bar.
+	if height > 1 {
+		t.pages.SetRect(x, y+1, width, height-1)
+		t.pages.Draw(screen)
+	}
+}
+
+// tabAtPoint returns the index of the tab at the given screen position and
+// whether the position is on that tab's close glyph. A negative index is
+// returned if there is no tab at that position.
+func (t *TabbedPages) tabAtPoint(x, y int) (index int, onClose bool) {
+	if y != t.barY {
+		return -1, false
+	}
+	for index, span := range t.spans {
+		if x >= span.from && x < span.to {
+			return index, x == span.closeX
+		}
+	}
+	return -1, false
+}
+
+// Focus is called when this primitive receives focus.
+func (t *TabbedPages) Focus(delegate func(p Primitive)) {
+	if _, item := t.GetCurrentTab(); item != nil {
+		delegate(item)
+		return
+	}
+	t.Box.Focus(delegate)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (t *TabbedPages) HasFocus() bool {
+	if len(t.tabs) > 0 && t.pages.HasFocus() {
+		return true
+	}
+	return t.Box.HasFocus()
+}
+
+// containedPrimitives returns the pages holding the tab contents. See
+// primitiveContainer.
+func (t *TabbedPages) containedPrimitives(visibleOnly bool) []Primitive {
+	return []Primitive{t.pages}
+}
+
+// InputHandler returns the handler for this primitive.
+func (t *TabbedPages) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
+	return t.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		if event.Key() == tcell.KeyCtrlW {
+			t.closeTab(t.current, setFocus)
+			return
+		}
+		if len(t.tabs) > 0 {
+			if handler := t.pages.InputHandler(); handler != nil {
+				handler(event, setFocus)
+			}
+		}
+	})
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (t *TabbedPages) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+	return t.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		x, y := event.Position()
+		if !t.InRect(x, y) {
+			t.hoverTab = -1
+			return false, nil
+		}
+
+		// Process events on the tab bar.
+		index, onClose := t.tabAtPoint(x, y)
+		if action == MouseMove {
+			t.hoverTab = index
+		}
+		if y == t.barY {
+			if action == MouseLeftClick && index >= 0 {
+				if onClose {
+					t.closeTab(index, setFocus)
+				} else {
+					t.switchTo(index)
+					if _, item := t.GetCurrentTab(); item != nil {
+						setFocus(item)
+					}
+				}
+			}
+			return action != MouseMove, nil
+		}
+
+		// Pass other events on to the active page.
+		if len(t.tabs) > 0 {
+			return t.pages.MouseHandler()(action, event, setFocus)
+		}
+		return
+	})
+}
@@ -52,10 +52,24 @@ type TableCell struct {
 	Clicked func() bool
 	Selected func(tc *TableCell, ref any) bool
 
+	// Whether the alignment was set with SetAlign(), overriding the column's
+	// alignment.
+	alignSet bool
+
 	// The position and width of the cell the last time table was drawn.
 	x, y, width int
 }
 
+// tableColumn holds the layout options of a table column.
+type tableColumn struct {
+	// The default alignment of the column's cells and whether it was set.
+	align    int
+	alignSet bool
+
+	// The minimum and maximum width of the column, 0 if unconstrained.
+	minWidth, maxWidth int
+}
+
 // NewTableCell returns a new table cell with sensible defaults. That is, left
 // aligned text with the primary text color (see Styles) and a transparent
 // background (using the background of the Table).
@@ -76,9 +90,11 @@ func (c *TableCell) SetText(text string) *TableCell {
 }
 
 // SetAlign sets the cell's text alignment, one of AlignLeft, AlignCenter, or
-// AlignRight.
+// AlignRight. This overrides the column's alignment set with
+// Table.SetColumnAlignment().
 func (c *TableCell) SetAlign(align int) *TableCell {
 	c.Align = align
+	c.alignSet = true
 	return c
 }
 
@@ -397,7 +413,9 @@ func (t *tableDefaultContent) GetColumnCount() int {
 // by lines. Therefore one table row will require two rows on screen.
 //
 // Columns will use as much horizontal space as they need. You can constrain
-// their size with the MaxWidth parameter of the TableCell type.
+// their size with the MaxWidth parameter of the TableCell type or for entire
+// columns with SetColumnWidth(). SetColumnAlignment() sets the default
+// alignment of a column's cells.
 //
 // Fixed Columns
 //
@@ -463,6 +481,10 @@ type Table struct {
 	// The currently selected row and column.
 	selectedRow, selectedColumn int
 
+	// The selected rows (if only rows are selectable) or columns (if only
+	// columns are selectable). Its current index follows the selection.
+	selection *SelectionModel
+
 	// A temporary flag which causes the next call to Draw() to force the
 	// current selection to remain visible. It is set to false afterwards.
 	clampToSelection bool
@@ -489,6 +511,9 @@ type Table struct {
 	// drawn.
 	visibleColumnWidths []int
 
+	// Layout options of individual columns, keyed by column index.
+	columns map[int]*tableColumn
+
 	// The style of the selected rows. If this value is the empty struct,
 	// selected rows are simply inverted.
 	selectedStyle tcell.Style
@@ -514,6 +539,7 @@ func NewTable() *Table {
 		Box:          NewBox(),
 		bordersColor: Styles.GraphicsColor,
 		separator:    ' ',
+		selection:    NewSelectionModel(SelectionSingle),
 	}
 	t.SetContent(nil)
 	return t
@@ -540,9 +566,89 @@ func (t *Table) SetContent(content TableContent) *Table {
 // Clear removes all table data.
 func (t *Table) Clear() *Table {
 	t.content.Clear()
+	t.selection.Clear()
+	return t
+}
+
+// SetColumnAlignment sets the alignment of the cells in the given column, one
+// of AlignLeft, AlignCenter, or AlignRight. Cells whose alignment was set with
+// TableCell.SetAlign() keep their own alignment.
+func (t *Table) SetColumnAlignment(column, align int) *Table {
+	layout := t.columnLayout(column)
+	layout.align, layout.alignSet = align, true
 	return t
 }
 
+// SetColumnWidth constrains the width of the given column to the range
+// between min and max (inclusive), where 0 means no constraint. The column's
+// width is determined by its content (see TableCell.SetMaxWidth()) and then
+// clamped to this range. Extra space distributed according to the cells'
+// expansion values (see TableCell.SetExpansion()) does not grow a column
+// beyond its maximum width. A column may still be narrower than its minimum
+// width if there is not enough room on screen.
+func (t *Table) SetColumnWidth(column, min, max int) *Table {
+	layout := t.columnLayout(column)
+	layout.minWidth, layout.maxWidth = min, max
+	return t
+}
+
+// columnLayout returns the layout options of the given column, creating them
+// if necessary.
+func (t *Table) columnLayout(column int) *tableColumn {
+	if t.columns == nil {
+		t.columns = make(map[int]*tableColumn)
+	}
+	layout, ok := t.columns[column]
+	if !ok {
+		layout = &tableColumn{}
+		t.columns[column] = layout
+	}
+	return layout
+}
+
+// clampColumnWidth returns the given width of a column, clamped to the column's
+// minimum and maximum width.
+func (t *Table) clampColumnWidth(column, width int) int {
+	if layout, ok := t.columns[column]; ok {
+		if layout.maxWidth > 0 && width > layout.maxWidth {
+			width = layout.maxWidth
+		}
+		if width < layout.minWidth {
+			width = layout.minWidth
+		}
+	}
+	return width
+}
+
+// cellAlign returns the alignment of the given cell in the given column.
+func (t *Table) cellAlign(cell *TableCell, column int) int {
+	if layout, ok := t.columns[column]; ok && layout.alignSet && !cell.alignSet {
+		return layout.align
+	}
+	return cell.Align
+}
+
+// shiftColumns moves the layout options of all columns at or to the right of
+// the given column by the given number of columns, dropping those of removed
+// columns.
+func (t *Table) shiftColumns(column, delta int) {
+	if len(t.columns) == 0 {
+		return
+	}
+	columns := make(map[int]*tableColumn, len(t.columns))
+	for index, layout := range t.columns {
+		switch {
+		case index < column:
+			columns[index] = layout
+		case delta < 0 && index < column-delta:
+			// Removed.
+		default:
+			columns[index+delta] = layout
+		}
+	}
+	t.columns = columns
+}
+
 // SetBorders sets whether or not each cell in the table is surrounded by a
 // border.
 func (t *Table) SetBorders(show bool) *Table {
@@ -619,7 +725,14 @@ func (t *Table) GetSelection() (row, column int) {
 // is available (even if the selection ends up being the same as before and even
 // if cells are not selectable).
 func (t *Table) Select(row, column int) *Table {
+	return t.selectExtended(row, column, false, false)
+}
+
+// selectExtended is like Select() but also updates the selection model with
+// the given flags, see SelectionModel.Select().
+func (t *Table) selectExtended(row, column int, extend, toggle bool) *Table {
 	t.selectedRow, t.selectedColumn = row, column
+	t.updateSelection(extend, toggle)
 	t.clampToSelection = true
 	if t.selectionChanged != nil {
 		t.selectionChanged(row, column)
@@ -627,6 +740,51 @@ func (t *Table) Select(row, column int) *Table {
 	return t
 }
 
+// SetSelectionMode sets how many rows (if only rows are selectable) or columns
+// (if only columns are selectable) can be selected at once: one of
+// SelectionSingle (the default), SelectionMulti, or SelectionRange. In the
+// latter two modes, Shift combined with the navigation keys or a mouse click
+// extends the selection from the anchor, and in SelectionMulti mode, Ctrl-click
+// toggles individual rows or columns. The mode has no effect if individual
+// cells are selectable.
+func (t *Table) SetSelectionMode(mode int) *Table {
+	t.selection.SetMode(mode)
+	return t
+}
+
+// GetSelectionModel returns the model holding the table's selected rows or
+// columns. Its GetSelection() function returns their indices.
+func (t *Table) GetSelectionModel() *SelectionModel {
+	return t.selection
+}
+
+// GetSelectedIndices returns the indices of all selected rows (if only rows are
+// selectable) or columns (if only columns are selectable) in ascending order.
+// nil is returned if neither or both are selectable; use GetSelection() in that
+// case.
+func (t *Table) GetSelectedIndices() []int {
+	if t.rowsSelectable == t.columnsSelectable {
+		return nil
+	}
+	return t.selection.GetSelection()
+}
+
+// updateSelection moves the selection model's current index to the selected
+// row or column. See SelectionModel.Select() for the meaning of the flags.
+func (t *Table) updateSelection(extend, toggle bool) {
+	if t.rowsSelectable && !t.columnsSelectable {
+		t.selection.Select(t.selectedRow, extend, toggle)
+	} else if t.columnsSelectable && !t.rowsSelectable {
+		t.selection.Select(t.selectedColumn, extend, toggle)
+	}
+}
+
+// isSelectedIndex returns whether the given row (if only rows are selectable)
+// or column (if only columns are selectable) is part of a multiple selection.
+func (t *Table) isSelectedIndex(index int) bool {
+	return t.selection.GetMode() != SelectionSingle && t.selection.IsSelected(index)
+}
+
 // SetOffset sets how many rows and columns should be skipped when drawing the
 // table. This is useful for large tables that do not fit on the screen.
 // Navigating a selection can change these values.
@@ -675,6 +833,7 @@ func (t *Table) GetInnerGeometry(rows []int) (w int, colIdxs []int, colWs []int)
 				}
 			}
 		}
+		maxWidth = t.clampColumnWidth(column, maxWidth)
 		clampedMaxWidth := maxWidth
 		if tableWidth+maxWidth > netWidth {
 			clampedMaxWidth = netWidth - tableWidth
@@ -797,6 +956,9 @@ func (t *Table) GetCell(row, column int) *TableCell {
 // no such row, this has no effect.
 func (t *Table) RemoveRow(row int) *Table {
 	t.content.RemoveRow(row)
+	if t.rowsSelectable && !t.columnsSelectable {
+		t.selection.Removed(row, t.content.GetRowCount())
+	}
 	return t
 }
 
@@ -804,6 +966,10 @@ func (t *Table) RemoveRow(row int) *Table {
 // there is no such column, this has no effect.
 func (t *Table) RemoveColumn(column int) *Table {
 	t.content.RemoveColumn(column)
+	t.shiftColumns(column, -1)
+	if t.columnsSelectable && !t.rowsSelectable {
+		t.selection.Removed(column, t.content.GetColumnCount())
+	}
 	return t
 }
 
@@ -812,6 +978,9 @@ func (t *Table) RemoveColumn(column int) *Table {
 // equal or larger than the current number of rows, this function has no effect.
 func (t *Table) InsertRow(row int) *Table {
 	t.content.InsertRow(row)
+	if t.rowsSelectable && !t.columnsSelectable && row < t.content.GetRowCount() {
+		t.selection.Inserted(row)
+	}
 	return t
 }
 
@@ -821,6 +990,10 @@ func (t *Table) InsertRow(row int) *Table {
 // unchanged.
 func (t *Table) InsertColumn(column int) *Table {
 	t.content.InsertColumn(column)
+	t.shiftColumns(column, 1)
+	if t.columnsSelectable && !t.rowsSelectable {
+		t.selection.Inserted(column)
+	}
 	return t
 }
 
@@ -916,7 +1089,9 @@ func (t *Table) SetWrapSelection(vertical, horizontal bool) *Table {
 
 // Draw draws this primitive onto the screen.
 func (t *Table) Draw(screen tcell.Screen) {
-	t.Box.DrawForSubclass(screen, t)
+	if !t.Box.DrawForSubclass(screen, t) {
+		return
+	}
 
 	// What's our available screen space?
 	_, totalHeight := screen.Size()
@@ -1074,6 +1249,7 @@ func (t *Table) Draw(screen tcell.Screen) {
 				}
 			}
 		}
+		maxWidth = t.clampColumnWidth(column, maxWidth)
 		clampedMaxWidth := maxWidth
 		if tableWidth+maxWidth > netWidth {
 			clampedMaxWidth = netWidth - tableWidth
@@ -1180,6 +1356,12 @@ func (t *Table) Draw(screen tcell.Screen) {
 				break
 			}
 			expWidth := toDistribute * expansion / expansionTotal
+			if layout, ok := t.columns[columns[index]]; ok && layout.maxWidth > 0 && widths[index]+expWidth > layout.maxWidth {
+				expWidth = layout.maxWidth - widths[index]
+				if expWidth < 0 {
+					expWidth = 0
+				}
+			}
 			widths[index] += expWidth
 			toDistribute -= expWidth
 			expansionTotal -= expansion
@@ -1244,7 +1426,7 @@ func (t *Table) Draw(screen tcell.Screen) {
 				finalWidth = width - columnX
 			}
 			cell.x, cell.y, cell.width = x+columnX, y+rowY, finalWidth
-			_, printed, _, _ := printWithStyle(screen, cell.Text, x+columnX, y+rowY, 0, finalWidth, cell.Align, tcell.StyleDefault.Foreground(cell.Color).Attributes(cell.Attributes), true)
+			_, printed, _, _ := printWithStyle(screen, cell.Text, x+columnX, y+rowY, 0, finalWidth, t.cellAlign(cell, column), tcell.StyleDefault.Foreground(cell.Color).Attributes(cell.Attributes), true)
 			if TaggedStringWidth(cell.Text)-printed > 0 && printed > 0 {
 				_, _, style, _ := screen.GetContent(x+columnX+finalWidth-1, y+rowY)
 				printWithStyle(screen, string(SemigraphicsHorizontalEllipsis), x+columnX+finalWidth-1, y+rowY, 0, 1, AlignLeft, style, false)
@@ -1345,7 +1527,7 @@ func (t *Table) Draw(screen tcell.Screen) {
 	var backgroundColors []tcell.Color
 	for rowY, row := range rows {
 		columnX := 0
-		rowSelected := t.rowsSelectable && !t.columnsSelectable && row == t.selectedRow
+		rowSelected := t.rowsSelectable && !t.columnsSelectable && (row == t.selectedRow || t.isSelectedIndex(row))
 		for columnIndex, column := range columns {
 			columnWidth := widths[columnIndex]
 			cell := t.content.GetCell(row, column)
@@ -1358,7 +1540,7 @@ func (t *Table) Draw(screen tcell.Screen) {
 				bw+=2
 				bh = 3
 			}
-			columnSelected := t.columnsSelectable && !t.rowsSelectable && column == t.selectedColumn
+			columnSelected := t.columnsSelectable && !t.rowsSelectable && (column == t.selectedColumn || t.isSelectedIndex(column))
 			cellSelected := !cell.NotSelectable && (columnSelected || rowSelected || t.rowsSelectable && t.columnsSelectable && column == t.selectedColumn && row == t.selectedRow)
 			entries, ok := cellsByBackgroundColor[cell.BackgroundColor]
 			cellsByBackgroundColor[cell.BackgroundColor] = append(entries, &cellInfo{
@@ -1693,10 +1875,12 @@ func (t *Table) InputHandler() func(event *tcell.EventKey, setFocus func(p Primi
 		}
 
 		// If the selection has changed, notify the handler.
-		if t.selectionChanged != nil &&
-			(t.rowsSelectable && previouslySelectedRow != t.selectedRow ||
-				t.columnsSelectable && previouslySelectedColumn != t.selectedColumn) {
-			t.selectionChanged(t.selectedRow, t.selectedColumn)
+		if t.rowsSelectable && previouslySelectedRow != t.selectedRow ||
+			t.columnsSelectable && previouslySelectedColumn != t.selectedColumn {
+			t.updateSelection(event.Modifiers()&tcell.ModShift != 0 && key != tcell.KeyRune, false)
+			if t.selectionChanged != nil {
+				t.selectionChanged(t.selectedRow, t.selectedColumn)
+			}
 		}
 	})
 }
@@ -1720,7 +1904,8 @@ func (t *Table) MouseHandler() func(action MouseAction, event *tcell.EventMouse,
 				}
 			}
 			if selectEvent && (t.rowsSelectable || t.columnsSelectable) {
-				t.Select(row, column)
+				mod := event.Modifiers()
+				t.selectExtended(row, column, mod&tcell.ModShift != 0, mod&(tcell.ModCtrl|tcell.ModMeta) != 0)
 			}
 			setFocus(t)
 			consumed = true
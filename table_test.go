@@ -0,0 +1,80 @@
+package tview
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// drawTable draws the table onto a simulation screen and returns the screen.
+func drawTable(t *testing.T, table *Table) tcell.SimulationScreen {
+	t.Helper()
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(screen.Fini)
+	screen.SetSize(40, 5)
+	table.SetRect(0, 0, 40, 5)
+	table.Draw(screen)
+	return screen
+}
+
+// checkColumnWidths checks the widths the cells of the given row were drawn
+// with.
+func checkColumnWidths(t *testing.T, table *Table, row int, expected ...int) {
+	t.Helper()
+	for column, width := range expected {
+		if _, _, w := table.GetCell(row, column).GetLastPosition(); w != width {
+			t.Errorf("column %d is %d cells wide, expected %d", column, w, width)
+		}
+	}
+}
+
+// TestTableColumnWidth checks that column widths are clamped to the minimum
+// and maximum widths of their columns.
+func TestTableColumnWidth(t *testing.T) {
+	table := NewTable().
+		SetCellSimple(0, 0, "a").
+		SetCellSimple(0, 1, "abcdefghij").
+		SetCellSimple(0, 2, "x").
+		SetColumnWidth(0, 5, 0).
+		SetColumnWidth(1, 0, 4)
+	drawTable(t, table)
+	checkColumnWidths(t, table, 0, 5, 4, 1)
+
+	// Expansion does not grow a column beyond its maximum width.
+	table.GetCell(0, 1).SetExpansion(1)
+	drawTable(t, table)
+	checkColumnWidths(t, table, 0, 5, 4, 1)
+
+	// Column options move with their columns.
+	table.InsertColumn(0).SetCellSimple(0, 0, "new")
+	drawTable(t, table)
+	checkColumnWidths(t, table, 0, 3, 5, 4, 1)
+	table.RemoveColumn(1)
+	drawTable(t, table)
+	checkColumnWidths(t, table, 0, 3, 4, 1)
+}
+
+// TestTableColumnAlignment checks that cells use their column's alignment
+// unless their own alignment was set.
+func TestTableColumnAlignment(t *testing.T) {
+	table := NewTable().
+		SetCellSimple(0, 0, "a").
+		SetCell(1, 0, NewTableCell("b").SetAlign(AlignLeft)).
+		SetColumnWidth(0, 5, 0).
+		SetColumnAlignment(0, AlignRight)
+	screen := drawTable(t, table)
+	for _, test := range []struct {
+		x, y int
+		r    rune
+	}{
+		{4, 0, 'a'},
+		{0, 1, 'b'},
+	} {
+		if r, _, _, _ := screen.GetContent(test.x, test.y); r != test.r {
+			t.Errorf("found %q at %d,%d, expected %q", r, test.x, test.y, test.r)
+		}
+	}
+}
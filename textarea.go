@@ -944,7 +944,9 @@ func (t *TextArea) replace(deleteStart, deleteEnd [3]int, insert string, continu
 
 // Draw draws this primitive onto the screen.
 func (t *TextArea) Draw(screen tcell.Screen) {
-	t.Box.DrawForSubclass(screen, t)
+	if !t.Box.DrawForSubclass(screen, t) {
+		return
+	}
 
 	// Prepare
 	x, y, width, height := t.GetInnerRect()
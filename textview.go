@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"unicode/utf8"
@@ -19,10 +20,15 @@ var (
 	openRegionRegex = regexp.MustCompile(`\["[a-zA-Z0-9_,;\*: \-\.]*"?$`)
 	newLineRegex    = regexp.MustCompile(`\r?\n`)
 
-	// TabSize is the number of spaces with which a tab character will be replaced.
+	// TabSize is the number of spaces with which a tab character will be
+	// replaced in a TextArea. TextView expands tabs to tab stops instead, see
+	// TextView.SetTabWidth().
 	TabSize = 4
 )
 
+// The width of the minimap, see TextView.SetMinimap().
+const textViewMinimapWidth = 2
+
 // textViewIndex contains information about a line displayed in the text view.
 type textViewIndex struct {
 	Line            int    // The index into the "buffer" slice.
@@ -35,6 +41,12 @@ type textViewIndex struct {
 	Region          string // The starting region ID.
 }
 
+// textViewMatch describes a search match within one line of the index.
+type textViewMatch struct {
+	Line     int // The index into the "index" slice.
+	From, To int // The byte positions of the match in the line's tag-stripped text.
+}
+
 // textViewRegion contains information about a region.
 type textViewRegion struct {
 	// The region ID.
@@ -127,6 +139,14 @@ func (w TextViewWriter) HasFocus() bool {
 // The ScrollToHighlight() function can be used to jump to the currently
 // highlighted region once when the text view is drawn the next time.
 //
+// Search
+//
+// Search() highlights all occurrences of a text. One of them, the current
+// match, is highlighted distinctly and can be cycled with NextMatch() and
+// PreviousMatch() (or the "n" and "N" keys). GetSearchMatchCount() and
+// GetCurrentMatchIndex() provide the information for a "3/17" indicator.
+// Matches do not extend across wrapped lines.
+//
 // Large Texts
 //
 // This widget is not designed for very large texts as word wrapping, color and
@@ -233,20 +253,59 @@ type TextView struct {
 	highlighted func(added, removed, remaining []string)
 
   styler Styler
+
+	// The search pattern, nil if there is no active search.
+	search *regexp.Regexp
+
+	// The matches of the search pattern in the current index.
+	searchMatches []textViewMatch
+
+	// Whether or not searchMatches was determined for the current index.
+	searchIndexed bool
+
+	// The index into searchMatches of the current match, -1 if there is none.
+	currentMatch int
+
+	// A temporary flag which, when true, will bring the current match into the
+	// visible screen.
+	scrollToMatch bool
+
+	// The styles of search matches and of the current match.
+	searchStyle, currentMatchStyle tcell.Style
+
+	// The distance between tab stops.
+	tabWidth int
+
+	// Whether or not a minimap is shown at the right edge.
+	minimap bool
+
+	// The styles of the minimap outside and inside the visible range.
+	minimapStyle, minimapViewportStyle tcell.Style
+
+	// The position and height of the minimap during the last draw.
+	minimapX, minimapY, minimapHeight int
+
+	// Set to true while the mouse is dragged on the minimap.
+	minimapDragging bool
 }
 
 // NewTextView returns a new text view.
 func NewTextView() *TextView {
 	return &TextView{
-		Box:           NewBox(),
-		highlights:    make(map[string]struct{}),
-		lineOffset:    -1,
-		scrollable:    true,
-		align:         AlignLeft,
-		wrap:          true,
-		textColor:     Styles.PrimaryTextColor,
-		regions:       false,
-		dynamicColors: false,
+		Box:               NewBox(),
+		highlights:        make(map[string]struct{}),
+		lineOffset:        -1,
+		scrollable:        true,
+		align:             AlignLeft,
+		wrap:              true,
+		textColor:         Styles.PrimaryTextColor,
+		regions:           false,
+		dynamicColors:     false,
+		currentMatch:      -1,
+		searchStyle:       tcell.StyleDefault.Foreground(tcell.ColorBlack).Background(tcell.ColorYellow),
+		currentMatchStyle: tcell.StyleDefault.Foreground(tcell.ColorBlack).Background(tcell.ColorOrange),
+		minimapX:          -1,
+		tabWidth:          8,
 	}
 }
 
@@ -317,6 +376,47 @@ func (t *TextView) SetTextColor(color tcell.Color) *TextView {
 	return t
 }
 
+// SetTabWidth sets the distance between tab stops. Tab characters are expanded
+// to spaces up to the next tab stop, counted in screen cells from the start of
+// the line (not including color or region tags). The default is 8. As tabs are
+// expanded when text is written, this only affects text written afterwards.
+func (t *TextView) SetTabWidth(width int) *TextView {
+	if width < 1 {
+		width = 1
+	}
+	t.tabWidth = width
+	return t
+}
+
+// expandTabs replaces the tab characters in the given text with spaces up to
+// the next tab stop, given that the text continues a line starting with the
+// given prefix.
+func (t *TextView) expandTabs(prefix, text string) string {
+	if !strings.Contains(text, "\t") {
+		return text
+	}
+	width := func(text string) int {
+		_, _, _, _, _, _, w := decomposeString(text, t.dynamicColors, t.regions)
+		return w
+	}
+	var expanded strings.Builder
+	column := width(prefix)
+	for {
+		tab := strings.IndexByte(text, '\t')
+		if tab < 0 {
+			break
+		}
+		expanded.WriteString(text[:tab])
+		column += width(text[:tab])
+		spaces := t.tabWidth - column%t.tabWidth
+		expanded.WriteString(strings.Repeat(" ", spaces))
+		column += spaces
+		text = text[tab+1:]
+	}
+	expanded.WriteString(text)
+	return expanded.String()
+}
+
 // SetText sets the text of this text view to the provided string. Previously
 // contained text will be removed.
 func (t *TextView) SetText(text string) *TextView {
@@ -599,6 +699,139 @@ func (t *TextView) ScrollToHighlight() *TextView {
 	return t
 }
 
+// Search highlights all occurrences of the given text and makes the first one
+// the current match, scrolling to it during the next draw. Color tags are not
+// part of the searched text. An empty text ends the search.
+func (t *TextView) Search(text string, ignoreCase bool) *TextView {
+	t.Lock()
+	defer t.Unlock()
+	if text == "" {
+		t.search, t.searchMatches, t.currentMatch = nil, nil, -1
+		return t
+	}
+	pattern := regexp.QuoteMeta(text)
+	if ignoreCase {
+		pattern = "(?i)" + pattern
+	}
+	t.search = regexp.MustCompile(pattern)
+	t.searchIndexed = false
+	t.currentMatch = -1
+	t.scrollToMatch = true
+	t.trackEnd = false
+	if t.index != nil {
+		t.indexSearch()
+	}
+	return t
+}
+
+// ClearSearch removes the search highlights.
+func (t *TextView) ClearSearch() *TextView {
+	return t.Search("", false)
+}
+
+// NextMatch makes the match following the current match the new current match
+// and scrolls to it during the next draw. After the last match, it continues
+// with the first match.
+func (t *TextView) NextMatch() *TextView {
+	return t.moveMatch(1)
+}
+
+// PreviousMatch makes the match preceding the current match the new current
+// match and scrolls to it during the next draw. Before the first match, it
+// continues with the last match.
+func (t *TextView) PreviousMatch() *TextView {
+	return t.moveMatch(-1)
+}
+
+// moveMatch moves the current match by the given number of matches.
+func (t *TextView) moveMatch(step int) *TextView {
+	t.Lock()
+	defer t.Unlock()
+	if t.search == nil {
+		return t
+	}
+	if !t.searchIndexed && t.index != nil {
+		t.indexSearch()
+	}
+	if len(t.searchMatches) == 0 {
+		return t
+	}
+	t.currentMatch = (t.currentMatch + step + len(t.searchMatches)) % len(t.searchMatches)
+	t.scrollToMatch = true
+	t.trackEnd = false
+	return t
+}
+
+// GetSearchMatchCount returns the number of matches of the current search. As
+// matches depend on the text view's width, this is 0 until the text view was
+// drawn at least once.
+func (t *TextView) GetSearchMatchCount() int {
+	t.Lock()
+	defer t.Unlock()
+	if t.search != nil && !t.searchIndexed && t.index != nil {
+		t.indexSearch()
+	}
+	return len(t.searchMatches)
+}
+
+// GetCurrentMatchIndex returns the (zero-based) index of the current match or
+// -1 if there is none.
+func (t *TextView) GetCurrentMatchIndex() int {
+	t.Lock()
+	defer t.Unlock()
+	return t.currentMatch
+}
+
+// SetSearchStyles sets the styles of search matches and of the current match.
+func (t *TextView) SetSearchStyles(match, current tcell.Style) *TextView {
+	t.searchStyle = match
+	t.currentMatchStyle = current
+	return t
+}
+
+// SetMinimap sets whether a narrow overview of the entire text is shown at the
+// right edge of the text view, with the currently visible lines highlighted.
+// Each row of the minimap stands for a number of lines, shaded by their
+// average width. Clicking or dragging on the minimap scrolls the text view.
+// The minimap reduces the width available to the text.
+func (t *TextView) SetMinimap(show bool) *TextView {
+	if t.minimap != show {
+		t.index = nil
+	}
+	t.minimap = show
+	return t
+}
+
+// SetMinimapStyles sets the styles of the minimap outside and inside the
+// visible range.
+func (t *TextView) SetMinimapStyles(normal, viewport tcell.Style) *TextView {
+	t.minimapStyle = normal
+	t.minimapViewportStyle = viewport
+	return t
+}
+
+// indexSearch determines the matches of the search pattern in the current
+// index.
+func (t *TextView) indexSearch() {
+	t.searchMatches = nil
+	t.searchIndexed = true
+	for line, index := range t.index {
+		text := t.buffer[index.Line][index.Pos:index.NextPos]
+		_, _, _, _, _, strippedText, _ := decomposeString(text, t.dynamicColors, t.regions)
+		for _, match := range t.search.FindAllStringIndex(strippedText, -1) {
+			if match[0] < match[1] {
+				t.searchMatches = append(t.searchMatches, textViewMatch{Line: line, From: match[0], To: match[1]})
+			}
+		}
+	}
+	if t.currentMatch >= len(t.searchMatches) {
+		t.currentMatch = len(t.searchMatches) - 1
+	}
+	if t.currentMatch < 0 && len(t.searchMatches) > 0 {
+		t.currentMatch = 0
+	}
+}
+
 // GetRegionText returns the text of the region with the given ID. If dynamic
 // colors are enabled, color tags are stripped from the text. Newlines are
 // always returned as '\n' runes.
@@ -691,8 +924,8 @@ func (t *TextView) HasFocus() bool {
 }
 
 // Write lets us implement the io.Writer interface. Tab characters will be
-// replaced with TabSize space characters. A "\n" or "\r\n" will be interpreted
-// as a new line.
+// expanded to the next tab stop (see SetTabWidth()). A "\n" or "\r\n" will be
+// interpreted as a new line.
 func (t *TextView) Write(p []byte) (n int, err error) {
 	t.Lock()
 	defer t.Unlock()
@@ -742,16 +975,15 @@ func (t *TextView) write(p []byte) (n int, err error) {
 	}
 
 	// Transform the new bytes into strings.
-	newBytes = bytes.Replace(newBytes, []byte{'\t'}, bytes.Repeat([]byte{' '}, TabSize), -1)
 	for index, line := range newLineRegex.Split(string(newBytes), -1) {
 		if index == 0 {
 			if len(t.buffer) == 0 {
-				t.buffer = []string{line}
+				t.buffer = []string{t.expandTabs("", line)}
 			} else {
-				t.buffer[len(t.buffer)-1] += line
+				t.buffer[len(t.buffer)-1] += t.expandTabs(t.buffer[len(t.buffer)-1], line)
 			}
 		} else {
-			t.buffer = append(t.buffer, line)
+			t.buffer = append(t.buffer, t.expandTabs("", line))
 		}
 	}
 
@@ -798,6 +1030,7 @@ func (t *TextView) reindexBuffer(width int) {
 	}
 	t.index = nil
 	t.fromHighlight, t.toHighlight, t.posHighlight = -1, -1, -1
+	t.searchIndexed = false
 
 	// If there's no space, there's no index.
 	if width < 1 {
@@ -1015,15 +1248,23 @@ func (t *TextView) reindexBuffer(width int) {
 
 // Draw draws this primitive onto the screen.
 func (t *TextView) Draw(screen tcell.Screen) {
-	t.Box.DrawForSubclass(screen, t)
+	if !t.Box.DrawForSubclass(screen, t) {
+		return
+	}
 	t.Lock()
 	defer t.Unlock()
-	totalWidth, totalHeight := screen.Size()
 
 	// Get the available size.
 	x, y, width, height := t.GetInnerRect()
 	t.pageSize = height
 
+	// Make room for the minimap.
+	t.minimapX = -1
+	if t.minimap && width > 2*textViewMinimapWidth {
+		width -= textViewMinimapWidth
+		t.minimapX, t.minimapY, t.minimapHeight = x+width, y, height
+	}
+
 	// If the width has changed, we need to reindex.
 	if width != t.lastWidth && t.wrap {
 		t.index = nil
@@ -1064,6 +1305,17 @@ func (t *TextView) Draw(screen tcell.Screen) {
 	}
 	t.scrollToHighlights = false
 
+	// Move to the current search match.
+	if t.search != nil && !t.searchIndexed {
+		t.indexSearch()
+	}
+	if t.scrollToMatch && t.currentMatch >= 0 {
+		if line := t.searchMatches[t.currentMatch].Line; line < t.lineOffset || line >= t.lineOffset+height {
+			t.lineOffset = line - height/2
+		}
+	}
+	t.scrollToMatch = false
+
 	// Adjust line offset.
 	if t.lineOffset+height > len(t.index) {
 		t.trackEnd = true
@@ -1105,10 +1357,94 @@ func (t *TextView) Draw(screen tcell.Screen) {
 	}
 
 	// Draw the buffer.
+	t.drawLines(screen, x, y, width, height, t.lineOffset)
+	if t.minimapX >= 0 {
+		t.drawMinimap(screen)
+	}
+
+	// If this view is not scrollable, we'll purge the buffer of lines that have
+	// scrolled out of view.
+	if !t.scrollable && t.lineOffset > 0 {
+		if t.lineOffset >= len(t.index) {
+			t.buffer = nil
+		} else {
+			t.buffer = t.buffer[t.index[t.lineOffset].Line:]
+		}
+		t.index = nil
+		t.lineOffset = 0
+	}
+
+	if t.scrollable {
+		t.DrawOverflow(screen, t.lineOffset != 0, !t.trackEnd)
+	}
+}
+
+// drawMinimap draws the minimap at the position determined by Draw().
+func (t *TextView) drawMinimap(screen tcell.Screen) {
+	shades := []rune{' ', '░', '▒', '▓', '█'}
+	lines := len(t.index)
+	longest := t.longestLine
+	if longest < 1 {
+		longest = 1
+	}
+	normal, viewport := t.minimapStyle, t.minimapViewportStyle
+	if normal == (tcell.Style{}) {
+		normal = tcell.StyleDefault.Foreground(Styles.TertiaryTextColor).Background(t.backgroundColor)
+	}
+	if viewport == (tcell.Style{}) {
+		viewport = normal.Background(Styles.ContrastBackgroundColor)
+	}
+	for row := 0; row < t.minimapHeight; row++ {
+		// Determine the lines covered by this row.
+		from := row * lines / t.minimapHeight
+		to := (row + 1) * lines / t.minimapHeight
+		if to <= from {
+			to = from + 1
+		}
+		style := normal
+		if from < t.lineOffset+t.minimapHeight && to > t.lineOffset {
+			style = viewport
+		}
+
+		// Shade the cells by the average line width.
+		var sum int
+		for line := from; line < to && line < lines; line++ {
+			sum += t.index[line].Width
+		}
+		fill := float64(sum) / float64((to-from)*longest) * textViewMinimapWidth
+		for column := 0; column < textViewMinimapWidth; column++ {
+			level := fill - float64(column)
+			if level < 0 {
+				level = 0
+			} else if level > 1 {
+				level = 1
+			}
+			shade := shades[int(level*float64(len(shades)-1)+0.5)]
+			screen.SetContent(t.minimapX+column, t.minimapY+row, shade, nil, style)
+		}
+	}
+}
+
+// scrollToMinimapRow scrolls the text such that the lines represented by the
+// given minimap row are centered.
+func (t *TextView) scrollToMinimapRow(row int) {
+	if row < 0 {
+		row = 0
+	} else if row >= t.minimapHeight {
+		row = t.minimapHeight - 1
+	}
+	t.trackEnd = false
+	t.lineOffset = row*len(t.index)/t.minimapHeight - t.pageSize/2
+}
+
+// drawLines draws the indexed lines starting at the given line offset into the
+// given screen area. The index must be up to date.
+func (t *TextView) drawLines(screen tcell.Screen, x, y, width, height, lineOffset int) {
+	totalWidth, totalHeight := screen.Size()
 	defaultStyle := tcell.StyleDefault.Foreground(t.textColor).Background(t.backgroundColor)
-	for line := t.lineOffset; line < len(t.index); line++ {
+	for line := lineOffset; line < len(t.index); line++ {
 		// Are we done?
-		if line-t.lineOffset >= height || y+line-t.lineOffset >= totalHeight {
+		if line-lineOffset >= height || y+line-lineOffset >= totalHeight {
 			break
 		}
 
@@ -1123,14 +1459,14 @@ func (t *TextView) Draw(screen tcell.Screen) {
 			if len(t.regionInfos) > 0 && t.regionInfos[len(t.regionInfos)-1].ID != regionID {
 				// End last region.
 				t.regionInfos[len(t.regionInfos)-1].ToX = x
-				t.regionInfos[len(t.regionInfos)-1].ToY = y + line - t.lineOffset
+				t.regionInfos[len(t.regionInfos)-1].ToY = y + line - lineOffset
 			}
 			if regionID != "" && (len(t.regionInfos) == 0 || t.regionInfos[len(t.regionInfos)-1].ID != regionID) {
 				// Start a new region.
 				t.regionInfos = append(t.regionInfos, &textViewRegion{
 					ID:    regionID,
 					FromX: x,
-					FromY: y + line - t.lineOffset,
+					FromY: y + line - lineOffset,
 					ToX:   -1,
 					ToY:   -1,
 				})
@@ -1140,6 +1476,14 @@ func (t *TextView) Draw(screen tcell.Screen) {
 		// Process tags.
 		colorTagIndices, colorTags, regionIndices, regions, escapeIndices, strippedText, _ := decomposeString(text, t.dynamicColors, t.regions)
 
+		// Find the first search match on this line.
+		matchPos := len(t.searchMatches)
+		if t.search != nil {
+			matchPos = sort.Search(len(t.searchMatches), func(i int) bool {
+				return t.searchMatches[i].Line >= line
+			})
+		}
+
 		// Calculate the position of the line.
 		var skip, posX int
 		if t.align == AlignLeft {
@@ -1155,7 +1499,7 @@ func (t *TextView) Draw(screen tcell.Screen) {
 		}
 
 		// Print the line.
-		if y+line-t.lineOffset >= 0 {
+		if y+line-lineOffset >= 0 {
 			var colorPos, regionPos, escapePos, tagOffset, skipped int
 			iterateString(strippedText, func(main rune, comb []rune, textPos, textWidth, screenPos, screenWidth int) bool {
 				// Process tags.
@@ -1170,7 +1514,7 @@ func (t *TextView) Draw(screen tcell.Screen) {
 						if regionID != "" && len(t.regionInfos) > 0 && t.regionInfos[len(t.regionInfos)-1].ID == regionID {
 							// End last region.
 							t.regionInfos[len(t.regionInfos)-1].ToX = x + posX
-							t.regionInfos[len(t.regionInfos)-1].ToY = y + line - t.lineOffset
+							t.regionInfos[len(t.regionInfos)-1].ToY = y + line - lineOffset
 						}
 						regionID = regions[regionPos][1]
 						if regionID != "" {
@@ -1178,7 +1522,7 @@ func (t *TextView) Draw(screen tcell.Screen) {
 							t.regionInfos = append(t.regionInfos, &textViewRegion{
 								ID:    regionID,
 								FromX: x + posX,
-								FromY: y + line - t.lineOffset,
+								FromY: y + line - lineOffset,
 								ToX:   -1,
 								ToY:   -1,
 							})
@@ -1221,6 +1565,18 @@ func (t *TextView) Draw(screen tcell.Screen) {
 					style = style.Background(fg).Foreground(bg)
 				}
 
+				// Is this character part of a search match?
+				for matchPos < len(t.searchMatches) && t.searchMatches[matchPos].Line == line && t.searchMatches[matchPos].To <= textPos {
+					matchPos++
+				}
+				if matchPos < len(t.searchMatches) && t.searchMatches[matchPos].Line == line && t.searchMatches[matchPos].From <= textPos {
+					if matchPos == t.currentMatch {
+						style = t.currentMatchStyle
+					} else {
+						style = t.searchStyle
+					}
+				}
+
 				// Skip to the right.
 				if !t.wrap && skipped < skip {
 					skipped += screenWidth
@@ -1235,9 +1591,9 @@ func (t *TextView) Draw(screen tcell.Screen) {
 				// Draw the character.
 				for offset := screenWidth - 1; offset >= 0; offset-- {
 					if offset == 0 {
-						screen.SetContent(x+posX+offset, y+line-t.lineOffset, main, comb, style)
+						screen.SetContent(x+posX+offset, y+line-lineOffset, main, comb, style)
 					} else {
-						screen.SetContent(x+posX+offset, y+line-t.lineOffset, ' ', nil, style)
+						screen.SetContent(x+posX+offset, y+line-lineOffset, ' ', nil, style)
 					}
 				}
 
@@ -1247,22 +1603,6 @@ func (t *TextView) Draw(screen tcell.Screen) {
 			})
 		}
 	}
-
-	// If this view is not scrollable, we'll purge the buffer of lines that have
-	// scrolled out of view.
-	if !t.scrollable && t.lineOffset > 0 {
-		if t.lineOffset >= len(t.index) {
-			t.buffer = nil
-		} else {
-			t.buffer = t.buffer[t.index[t.lineOffset].Line:]
-		}
-		t.index = nil
-		t.lineOffset = 0
-	}
-
-  if t.scrollable {
-    t.DrawOverflow(screen, t.lineOffset != 0, !t.trackEnd)
-  }
 }
 
 // InputHandler returns the handler for this primitive.
@@ -1300,6 +1640,10 @@ func (t *TextView) InputHandler() func(event *tcell.EventKey, setFocus func(p Pr
 				t.columnOffset--
 			case 'l': // Right.
 				t.columnOffset++
+			case 'n': // Next search match.
+				t.moveMatch(1)
+			case 'N': // Previous search match.
+				t.moveMatch(-1)
 			}
 		case tcell.KeyHome:
 			t.trackEnd = false
@@ -1330,6 +1674,31 @@ func (t *TextView) InputHandler() func(event *tcell.EventKey, setFocus func(p Pr
 func (t *TextView) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
 	return t.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
 		x, y := event.Position()
+
+		// Handle the minimap.
+		if t.minimapDragging {
+			switch action {
+			case MouseMove:
+				t.scrollToMinimapRow(y - t.minimapY)
+			case MouseLeftUp:
+				t.minimapDragging = false
+				return true, nil
+			}
+			return true, t
+		}
+		if t.minimapX >= 0 && x >= t.minimapX && x < t.minimapX+textViewMinimapWidth &&
+			y >= t.minimapY && y < t.minimapY+t.minimapHeight {
+			switch action {
+			case MouseLeftDown:
+				setFocus(t)
+				t.scrollToMinimapRow(y - t.minimapY)
+				t.minimapDragging = true
+				return true, t
+			case MouseLeftClick:
+				return true, nil
+			}
+		}
+
 		if !t.InRect(x, y) {
 			return false, nil
 		}
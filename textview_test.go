@@ -0,0 +1,99 @@
+package tview
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// drawTextView draws the text view onto a simulation screen so that its
+// index is built.
+func drawTextView(t *testing.T, textView *TextView) {
+	t.Helper()
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		t.Fatal(err)
+	}
+	defer screen.Fini()
+	screen.SetSize(40, 10)
+	textView.SetRect(0, 0, 40, 10)
+	textView.Draw(screen)
+}
+
+// TestTextViewSearch checks the match count and the current match of TextView
+// searches.
+func TestTextViewSearch(t *testing.T) {
+	textView := NewTextView().
+		SetDynamicColors(true).
+		SetText("one two one\nthree One\nt[red]w[white]o")
+	drawTextView(t, textView)
+
+	tests := []struct {
+		name    string
+		apply   func()
+		count   int
+		current int
+	}{
+		{"case-sensitive", func() { textView.Search("one", false) }, 2, 0},
+		{"next", func() { textView.NextMatch() }, 2, 1},
+		{"next, wrapping", func() { textView.NextMatch() }, 2, 0},
+		{"previous, wrapping", func() { textView.PreviousMatch() }, 2, 1},
+		{"ignoring case", func() { textView.Search("one", true) }, 3, 0},
+		{"across color tags", func() { textView.Search("two", false) }, 2, 0},
+		{"no match", func() { textView.Search("four", false) }, 0, -1},
+		{"next without match", func() { textView.NextMatch() }, 0, -1},
+		{"cleared", func() { textView.Search("one", false).ClearSearch() }, 0, -1},
+	}
+	for _, test := range tests {
+		test.apply()
+		if count := textView.GetSearchMatchCount(); count != test.count {
+			t.Errorf("%s: %d matches, expected %d", test.name, count, test.count)
+		}
+		if current := textView.GetCurrentMatchIndex(); current != test.current {
+			t.Errorf("%s: current match is %d, expected %d", test.name, current, test.current)
+		}
+	}
+}
+
+// TestTextViewSearchBeforeDraw checks that searches are evaluated once the
+// text view was drawn.
+func TestTextViewSearchBeforeDraw(t *testing.T) {
+	textView := NewTextView().SetText("abc abc")
+	textView.Search("abc", false)
+	if count := textView.GetSearchMatchCount(); count != 0 {
+		t.Errorf("%d matches before drawing, expected 0", count)
+	}
+	drawTextView(t, textView)
+	if count := textView.GetSearchMatchCount(); count != 2 {
+		t.Errorf("%d matches after drawing, expected 2", count)
+	}
+}
+
+// TestTextViewTabs checks that tabs are expanded to the next tab stop,
+// ignoring color tags and across separate writes.
+func TestTextViewTabs(t *testing.T) {
+	for _, test := range []struct {
+		tabWidth int
+		writes   []string
+		expected string
+	}{
+		{8, []string{"a\tb"}, "a       b"},
+		{4, []string{"\tx\n12345\ty"}, "    x\n12345   y"},
+		{4, []string{"[red]ab[white]\tc"}, "ab  c"},
+		{4, []string{"abc", "\td"}, "abc d"},
+		{2, []string{"\t\t"}, "    "},
+	} {
+		textView := NewTextView().
+			SetDynamicColors(true).
+			SetTabWidth(test.tabWidth)
+		for _, text := range test.writes {
+			if _, err := textView.Write([]byte(text)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if text := strings.TrimSuffix(textView.GetText(true), "\n"); text != test.expected {
+			t.Errorf("%q with tab width %d expanded to %q, expected %q", test.writes, test.tabWidth, text, test.expected)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package tview
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// TransferList lets the user pick a subset of items by moving them between two
+// lists: the available items on the left and the selected items on the right.
+// The buttons between the lists move the current item (">" and "<") or all
+// items (">>" and "<<") to the other side. Items which are moved back to the
+// left list return to their original position.
+//
+// The following keys are available:
+//
+//   - Up/down arrows, Home, End, PgUp, PgDn: Navigate within a list.
+//   - Enter, space: Move the current item to the other list or, on a button,
+//     press it.
+//   - Tab, Backtab: Move the focus between the lists and the buttons.
+//
+// Double-clicking an item moves it to the other list.
+type TransferList struct {
+	*Flex
+
+	// The lists of available and selected items.
+	available, selected *List
+
+	// The buttons moving items between the lists.
+	moveRight, moveAllRight, moveLeft, moveAllLeft *Button
+
+	// The original position of each item, used to restore the order of the
+	// available items.
+	order map[string]int
+
+	// An optional function which is called when items were moved.
+	changed func(selected []string)
+}
+
+// NewTransferList returns a new transfer list without any items.
+func NewTransferList() *TransferList {
+	t := &TransferList{
+		available: NewList().ShowSecondaryText(false),
+		selected:  NewList().ShowSecondaryText(false),
+		order:     make(map[string]int),
+	}
+	t.available.SetBorder(true).SetTitle("Available")
+	t.selected.SetBorder(true).SetTitle("Selected")
+	t.moveRight = NewButton(">").SetSelectedFunc(func() { t.move(t.available, t.selected, false) })
+	t.moveAllRight = NewButton(">>").SetSelectedFunc(func() { t.move(t.available, t.selected, true) })
+	t.moveLeft = NewButton("<").SetSelectedFunc(func() { t.move(t.selected, t.available, false) })
+	t.moveAllLeft = NewButton("<<").SetSelectedFunc(func() { t.move(t.selected, t.available, true) })
+
+	buttons := NewFlex().SetDirection(FlexRow).
+		AddItem(nil, 0, 1, false).
+		AddItem(t.moveRight, 1, 0, false).
+		AddItem(nil, 1, 0, false).
+		AddItem(t.moveAllRight, 1, 0, false).
+		AddItem(nil, 1, 0, false).
+		AddItem(t.moveLeft, 1, 0, false).
+		AddItem(nil, 1, 0, false).
+		AddItem(t.moveAllLeft, 1, 0, false).
+		AddItem(nil, 0, 1, false)
+
+	t.Flex = NewFlex().
+		AddItem(t.available, 0, 1, true).
+		AddItem(NewFlex().
+			AddItem(nil, 1, 0, false).
+			AddItem(buttons, 0, 1, false).
+			AddItem(nil, 1, 0, false), 6, 0, false).
+		AddItem(t.selected, 0, 1, false)
+	return t
+}
+
+// AddItem appends an item to the left (if selected is false) or right (if
+// selected is true) list.
+func (t *TransferList) AddItem(text string, selected bool) *TransferList {
+	if _, ok := t.order[text]; !ok {
+		t.order[text] = len(t.order)
+	}
+	if selected {
+		t.selected.AddItem(text, "", 0, nil)
+	} else {
+		t.insertAvailable(text)
+	}
+	return t
+}
+
+// Clear removes all items from both lists.
+func (t *TransferList) Clear() *TransferList {
+	t.available.Clear()
+	t.selected.Clear()
+	t.order = make(map[string]int)
+	return t
+}
+
+// GetSelected returns the items of the right list in the order they were
+// selected.
+func (t *TransferList) GetSelected() []string {
+	return listTexts(t.selected)
+}
+
+// GetAvailable returns the items of the left list.
+func (t *TransferList) GetAvailable() []string {
+	return listTexts(t.available)
+}
+
+// GetLists returns the lists of available and selected items, e.g. to change
+// their styles or titles.
+func (t *TransferList) GetLists() (available, selected *List) {
+	return t.available, t.selected
+}
+
+// SetChangedFunc sets a handler which is called with the selected items
+// whenever items were moved between the lists.
+func (t *TransferList) SetChangedFunc(handler func(selected []string)) *TransferList {
+	t.changed = handler
+	return t
+}
+
+// listTexts returns the main texts of all items of the given list.
+func listTexts(list *List) []string {
+	texts := make([]string, list.GetItemCount())
+	for index := range texts {
+		texts[index], _ = list.GetItemText(index)
+	}
+	return texts
+}
+
+// insertAvailable inserts the given item into the left list, at its original
+// position relative to the other items.
+func (t *TransferList) insertAvailable(text string) {
+	position := t.order[text]
+	for index := 0; index < t.available.GetItemCount(); index++ {
+		if other, _ := t.available.GetItemText(index); t.order[other] > position {
+			t.available.InsertItem(index, text, "", 0, nil)
+			return
+		}
+	}
+	t.available.AddItem(text, "", 0, nil)
+}
+
+// move moves the current item (or all items) from one list to the other.
+func (t *TransferList) move(from, to *List, all bool) {
+	if from.GetItemCount() == 0 {
+		return
+	}
+	var texts []string
+	if all {
+		texts = listTexts(from)
+		from.Clear()
+	} else {
+		current := from.GetCurrentItem()
+		text, _ := from.GetItemText(current)
+		texts = []string{text}
+		from.RemoveItem(current)
+	}
+	for _, text := range texts {
+		if to == t.available {
+			t.insertAvailable(text)
+		} else {
+			to.AddItem(text, "", 0, nil)
+		}
+	}
+	if t.changed != nil {
+		t.changed(t.GetSelected())
+	}
+}
+
+// InputHandler returns the handler for this primitive.
+func (t *TransferList) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
+	return t.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		// The focus ring.
+		ring := []Primitive{t.available, t.moveRight, t.moveAllRight, t.moveLeft, t.moveAllLeft, t.selected}
+		focused := -1
+		for index, p := range ring {
+			if p.HasFocus() {
+				focused = index
+				break
+			}
+		}
+
+		key := event.Key()
+		switch {
+		case key == tcell.KeyTab:
+			setFocus(ring[(focused+1)%len(ring)])
+			return
+		case key == tcell.KeyBacktab:
+			if focused <= 0 {
+				focused = len(ring)
+			}
+			setFocus(ring[focused-1])
+			return
+		case key == tcell.KeyEnter || key == tcell.KeyRune && event.Rune() == ' ':
+			if t.available.HasFocus() {
+				t.move(t.available, t.selected, false)
+				return
+			} else if t.selected.HasFocus() {
+				t.move(t.selected, t.available, false)
+				return
+			}
+		}
+
+		if focused >= 0 {
+			if handler := ring[focused].InputHandler(); handler != nil {
+				handler(event, setFocus)
+			}
+		}
+	})
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (t *TransferList) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+	return t.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		if action == MouseLeftDoubleClick {
+			for _, list := range []*List{t.available, t.selected} {
+				if !list.InRect(event.Position()) {
+					continue
+				}
+				// Select the clicked item, then move it.
+				list.MouseHandler()(MouseLeftClick, event, setFocus)
+				if list == t.available {
+					t.move(t.available, t.selected, false)
+				} else {
+					t.move(t.selected, t.available, false)
+				}
+				return true, nil
+			}
+		}
+		return t.Flex.MouseHandler()(action, event, setFocus)
+	})
+}
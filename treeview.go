@@ -194,7 +194,7 @@ func (n *TreeNode) ExpandAll() *TreeNode {
 // CollapseAll collapses this node and all descendent nodes.
 func (n *TreeNode) CollapseAll() *TreeNode {
 	n.Walk(func(node, parent *TreeNode) bool {
-		n.expanded = false
+		node.expanded = false
 		return true
 	})
 	return n
@@ -310,6 +310,10 @@ type TreeView struct {
 	// An optional function which is called when a tree item was selected.
 	selected func(node *TreeNode)
 
+	// An optional function which populates nodes without children before
+	// ExpandAll() or ExpandToLevel() expands them.
+	loadChildren func(node *TreeNode)
+
 	// An optional function which is called when the user moves away from this
 	// primitive.
 	done func(key tcell.Key)
@@ -355,6 +359,95 @@ func (t *TreeView) GetCurrentNode() *TreeNode {
 	return t.currentNode
 }
 
+// ExpandAll expands all nodes of the tree. If a child loader is set (see
+// SetChildLoader()), it is called for every node without children, so the
+// entire tree is loaded.
+func (t *TreeView) ExpandAll() *TreeView {
+	if t.root != nil {
+		t.root.Walk(func(node, parent *TreeNode) bool {
+			t.loadNode(node)
+			node.expanded = true
+			return true
+		})
+	}
+	return t
+}
+
+// CollapseAll collapses all nodes of the tree. If the current node is hidden
+// as a result, its top-most collapsed ancestor becomes the current node.
+func (t *TreeView) CollapseAll() *TreeView {
+	if t.root != nil {
+		t.root.CollapseAll()
+		t.revealCurrentNode()
+	}
+	return t
+}
+
+// ExpandToLevel expands all nodes above the given hierarchy level and
+// collapses all others, so that nodes down to the given level are visible
+// (where 0 refers to the root, 1 to the root's child nodes, and so on). If
+// the current node is hidden as a result, its top-most collapsed ancestor
+// becomes the current node.
+//
+// Nodes whose children are populated on demand are expanded with the children
+// they have unless a child loader is set, see SetChildLoader().
+func (t *TreeView) ExpandToLevel(level int) *TreeView {
+	if t.root == nil {
+		return t
+	}
+	levels := map[*TreeNode]int{t.root: 0}
+	t.root.Walk(func(node, parent *TreeNode) bool {
+		if parent != nil {
+			levels[node] = levels[parent] + 1
+		}
+		if levels[node] < level {
+			t.loadNode(node)
+		}
+		node.expanded = levels[node] < level
+		return true
+	})
+	t.revealCurrentNode()
+	return t
+}
+
+// SetChildLoader sets a function which populates the children of a node, e.g.
+// with the entries of a directory, for trees which are loaded on demand. If
+// set, ExpandAll() and ExpandToLevel() call it for every node without children
+// which they expand before descending into it. If nil (the default), these
+// functions only expand the children already added to the tree.
+func (t *TreeView) SetChildLoader(loader func(node *TreeNode)) *TreeView {
+	t.loadChildren = loader
+	return t
+}
+
+// loadNode calls the child loader for the given node if it has no children.
+func (t *TreeView) loadNode(node *TreeNode) {
+	if t.loadChildren != nil && len(node.children) == 0 {
+		t.loadChildren(node)
+	}
+}
+
+// revealCurrentNode replaces the current node with its top-most collapsed
+// ancestor if the current node is hidden by a collapsed ancestor.
+func (t *TreeView) revealCurrentNode() {
+	if t.currentNode == nil || t.root == nil {
+		return
+	}
+	parents := make(map[*TreeNode]*TreeNode)
+	t.root.Walk(func(node, parent *TreeNode) bool {
+		parents[node] = parent
+		return true
+	})
+	if _, ok := parents[t.currentNode]; !ok {
+		return
+	}
+	for node := parents[t.currentNode]; node != nil; node = parents[node] {
+		if !node.expanded {
+			t.currentNode = node
+		}
+	}
+}
+
 // SetTopLevel sets the first tree level that is visible with 0 referring to the
 // root, 1 to the root's child nodes, and so on. Nodes above the top level are
 // not displayed.
@@ -621,7 +714,9 @@ func (t *TreeView) Process() {
 
 // Draw draws this primitive onto the screen.
 func (t *TreeView) Draw(screen tcell.Screen) {
-	t.Box.DrawForSubclass(screen, t)
+	if !t.Box.DrawForSubclass(screen, t) {
+		return
+	}
 	if t.root == nil {
 		return
 	}
@@ -0,0 +1,114 @@
+package tview
+
+import (
+	"strings"
+	"testing"
+)
+
+// newTestTree returns the following tree, with all nodes expanded:
+//
+//	root
+//	├── a
+//	│   ├── a1
+//	│   │   └── a1x
+//	│   └── a2
+//	└── b
+//	    └── b1
+func newTestTree() (*TreeView, map[string]*TreeNode) {
+	nodes := make(map[string]*TreeNode)
+	node := func(text string, children ...*TreeNode) *TreeNode {
+		nodes[text] = NewTreeNode(text).SetChildren(children)
+		return nodes[text]
+	}
+	root := node("root",
+		node("a",
+			node("a1", node("a1x")),
+			node("a2")),
+		node("b", node("b1")))
+	return NewTreeView().SetRoot(root).SetCurrentNode(root), nodes
+}
+
+// visibleNodes returns the texts of the visible nodes of the tree, top-down.
+func visibleNodes(tree *TreeView) string {
+	tree.Process()
+	var texts []string
+	for _, node := range tree.nodes {
+		texts = append(texts, node.GetText())
+	}
+	return strings.Join(texts, " ")
+}
+
+// TestTreeViewExpansion checks the visible nodes after ExpandAll(),
+// CollapseAll(), and ExpandToLevel().
+func TestTreeViewExpansion(t *testing.T) {
+	tree, nodes := newTestTree()
+
+	tests := []struct {
+		name     string
+		apply    func()
+		expected string
+	}{
+		{"CollapseAll", func() { tree.CollapseAll() }, "root"},
+		{"ExpandToLevel(1)", func() { tree.ExpandToLevel(1) }, "root a b"},
+		{"ExpandToLevel(2)", func() { tree.ExpandToLevel(2) }, "root a a1 a2 b b1"},
+		{"ExpandAll", func() { tree.ExpandAll() }, "root a a1 a1x a2 b b1"},
+		{"ExpandToLevel(0)", func() { tree.ExpandToLevel(0) }, "root"},
+		{"ExpandToLevel(10)", func() { tree.ExpandToLevel(10) }, "root a a1 a1x a2 b b1"},
+	}
+	for _, test := range tests {
+		test.apply()
+		if visible := visibleNodes(tree); visible != test.expected {
+			t.Errorf("%s: visible nodes are %q, expected %q", test.name, visible, test.expected)
+		}
+	}
+
+	// A hidden current node is replaced by its top-most collapsed ancestor.
+	tree.ExpandAll().SetCurrentNode(nodes["a1x"])
+	tree.ExpandToLevel(1)
+	if current := tree.GetCurrentNode(); current != nodes["a"] {
+		t.Errorf("current node after ExpandToLevel(1) is %q, expected %q", current.GetText(), "a")
+	}
+	tree.CollapseAll()
+	if current := tree.GetCurrentNode(); current != nodes["root"] {
+		t.Errorf("current node after CollapseAll() is %q, expected %q", current.GetText(), "root")
+	}
+}
+
+// TestTreeViewChildLoader checks that ExpandAll() and ExpandToLevel() only
+// populate nodes on demand if a child loader is set.
+func TestTreeViewChildLoader(t *testing.T) {
+	root := NewTreeNode("root")
+	tree := NewTreeView().SetRoot(root)
+
+	tree.ExpandAll()
+	if visible := visibleNodes(tree); visible != "root" {
+		t.Errorf("without loader: visible nodes are %q, expected %q", visible, "root")
+	}
+
+	// Each node gets two children, down to the third level.
+	var loaded []string
+	tree.SetChildLoader(func(node *TreeNode) {
+		text := node.GetText()
+		loaded = append(loaded, text)
+		if len(text) < 3 {
+			node.AddChild(NewTreeNode(text + "a")).AddChild(NewTreeNode(text + "b"))
+		}
+	})
+	root.SetText("r")
+	tree.ExpandToLevel(1)
+	if visible := visibleNodes(tree); visible != "r ra rb" {
+		t.Errorf("ExpandToLevel(1): visible nodes are %q, expected %q", visible, "r ra rb")
+	}
+	if strings.Join(loaded, " ") != "r" {
+		t.Errorf("ExpandToLevel(1): loaded %q, expected %q", loaded, "r")
+	}
+
+	loaded = nil
+	tree.ExpandAll()
+	if visible, expected := visibleNodes(tree), "r ra raa rab rb rba rbb"; visible != expected {
+		t.Errorf("ExpandAll: visible nodes are %q, expected %q", visible, expected)
+	}
+	if loaded, expected := strings.Join(loaded, " "), "ra raa rab rb rba rbb"; loaded != expected {
+		t.Errorf("ExpandAll: loaded %q, expected %q", loaded, expected)
+	}
+}
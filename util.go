@@ -734,3 +734,27 @@ func stripTags(text string) string {
 	})
 	return escapePattern.ReplaceAllString(stripped, `[$1$2]`)
 }
+
+// ContrastingColor returns black for light background colors and white for
+// dark ones, based on the relative luminance of the background (as defined in
+// WCAG 2). The terminal's default color is assumed to be dark.
+func ContrastingColor(background tcell.Color) tcell.Color {
+	r, g, b := background.RGB()
+	if r < 0 {
+		return tcell.ColorWhite
+	}
+	linear := func(c int32) float64 {
+		v := float64(c) / 255
+		if v <= 0.03928 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	luminance := 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+
+	// The luminance at which the contrast to black and white is equal.
+	if luminance > 0.179 {
+		return tcell.ColorBlack
+	}
+	return tcell.ColorWhite
+}
@@ -0,0 +1,440 @@
+package tview
+
+import (
+	"strconv"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// wizardStep is one step of a Wizard.
+type wizardStep struct {
+	title    string
+	content  Primitive
+	validate func() error
+}
+
+// Wizard guides the user through an ordered sequence of steps, each shown on
+// its own page. A stepper header at the top lists the titles of all steps and
+// highlights the current one. Back and Next buttons at the bottom move between
+// steps. On the last step, the Next button becomes a Finish button.
+//
+// Each step may provide a validation function. The user can only advance to
+// the next step (or finish) if it returns nil. Otherwise, the returned error is
+// shown above the buttons. Going back is always possible and step contents are
+// kept, so entered values are preserved.
+//
+// The following keys are available in addition to those of the step contents
+// and the buttons:
+//
+//   - Ctrl-N: Advance to the next step or finish.
+//   - Ctrl-P: Go back to the previous step.
+//   - Escape: Move the focus from the step content to the buttons. On the
+//     buttons, invokes the cancel handler (see SetCancelFunc()).
+//   - Tab, Backtab: Move between the buttons and back to the step content.
+type Wizard struct {
+	*Box
+
+	// The steps in the order they are visited.
+	steps []*wizardStep
+
+	// The index of the current step.
+	current int
+
+	// The pages holding the step contents.
+	pages *Pages
+
+	// The navigation buttons.
+	back, next *Button
+
+	// The error returned by the last failed validation, empty if there was
+	// none.
+	errorText string
+
+	// The styles of the stepper header for completed, current, and upcoming
+	// steps.
+	doneStyle, currentStyle, upcomingStyle tcell.Style
+
+	// The color of validation errors.
+	errorColor tcell.Color
+
+	// The labels of the Next button on the last step and on all other steps.
+	finishLabel, nextLabel string
+
+	// An optional function which is called when the user finishes the last
+	// step.
+	finished func(data map[string]map[string]string)
+
+	// An optional function which is called when the user cancels the wizard.
+	cancel func()
+
+	// An optional function which is called when the current step changes.
+	changed func(index int, title string)
+
+	// The last function which was used to set the focus.
+	setFocus func(p Primitive)
+}
+
+// NewWizard returns a new wizard without any steps.
+func NewWizard() *Wizard {
+	w := &Wizard{
+		Box:           NewBox(),
+		pages:         NewPages(),
+		doneStyle:     tcell.StyleDefault.Foreground(Styles.TertiaryTextColor),
+		currentStyle:  tcell.StyleDefault.Foreground(Styles.PrimaryTextColor).Bold(true),
+		upcomingStyle: tcell.StyleDefault.Foreground(Styles.SecondaryTextColor),
+		errorColor:    tcell.ColorRed,
+		finishLabel:   "Finish",
+		nextLabel:     "Next",
+	}
+	w.pages.SetParent(w)
+	w.back = NewButton("Back").SetSelectedFunc(w.Back)
+	w.next = NewButton(w.nextLabel).SetSelectedFunc(w.Next)
+	w.back.SetParent(w)
+	w.next.SetParent(w)
+	exit := func(key tcell.Key) {
+		w.exitButton(key)
+	}
+	w.back.SetExitFunc(exit)
+	w.next.SetExitFunc(exit)
+	return w
+}
+
+// AddStep appends a step with the given title and content. The content is
+// typically a Form but may be any primitive. The optional validation function
+// is called when the user attempts to leave the step towards the next one (or
+// to finish). If it returns an error, the step is not left.
+func (w *Wizard) AddStep(title string, content Primitive, validate func() error) *Wizard {
+	w.steps = append(w.steps, &wizardStep{
+		title:    title,
+		content:  content,
+		validate: validate,
+	})
+	index := len(w.steps) - 1
+	w.pages.AddPage(strconv.Itoa(index), content, true, index == w.current)
+	w.updateButtons()
+	return w
+}
+
+// GetStepCount returns the number of steps.
+func (w *Wizard) GetStepCount() int {
+	return len(w.steps)
+}
+
+// GetCurrentStep returns the index of the current step.
+func (w *Wizard) GetCurrentStep() int {
+	return w.current
+}
+
+// SetButtonLabels sets the labels of the Back button, the Next button, and the
+// Next button on the last step.
+func (w *Wizard) SetButtonLabels(back, next, finish string) *Wizard {
+	w.back.SetLabel(back)
+	w.nextLabel, w.finishLabel = next, finish
+	w.updateButtons()
+	return w
+}
+
+// SetStepperStyles sets the styles used in the stepper header for completed
+// steps, the current step, and upcoming steps.
+func (w *Wizard) SetStepperStyles(done, current, upcoming tcell.Style) *Wizard {
+	w.doneStyle, w.currentStyle, w.upcomingStyle = done, current, upcoming
+	return w
+}
+
+// SetErrorColor sets the color of validation error messages.
+func (w *Wizard) SetErrorColor(color tcell.Color) *Wizard {
+	w.errorColor = color
+	return w
+}
+
+// SetFinishedFunc sets a handler which is called when the user finishes the
+// last step (after its validation succeeded). It receives the values of all
+// form items of all steps whose content is a Form, keyed by step title and
+// then by item label. Input fields provide their text, drop-down fields the
+// text of the selected option, and checkboxes "true" or "false".
+func (w *Wizard) SetFinishedFunc(handler func(data map[string]map[string]string)) *Wizard {
+	w.finished = handler
+	return w
+}
+
+// SetCancelFunc sets a handler which is called when the user presses Escape
+// while one of the buttons has focus.
+func (w *Wizard) SetCancelFunc(handler func()) *Wizard {
+	w.cancel = handler
+	return w
+}
+
+// SetChangedFunc sets a handler which is called when the current step changes.
+func (w *Wizard) SetChangedFunc(handler func(index int, title string)) *Wizard {
+	w.changed = handler
+	return w
+}
+
+// Next validates the current step and advances to the next one. On the last
+// step, the finished handler is called instead.
+func (w *Wizard) Next() {
+	if w.current >= len(w.steps) {
+		return
+	}
+	if validate := w.steps[w.current].validate; validate != nil {
+		if err := validate(); err != nil {
+			w.errorText = err.Error()
+			return
+		}
+	}
+	w.errorText = ""
+	if w.current == len(w.steps)-1 {
+		if w.finished != nil {
+			w.finished(w.GetData())
+		}
+		return
+	}
+	w.switchTo(w.current + 1)
+}
+
+// Back returns to the previous step without validating the current one.
+func (w *Wizard) Back() {
+	if w.current > 0 {
+		w.errorText = ""
+		w.switchTo(w.current - 1)
+	}
+}
+
+// GetData returns the values of the form items of all steps whose content is a
+// Form. See SetFinishedFunc() for details.
+func (w *Wizard) GetData() map[string]map[string]string {
+	data := make(map[string]map[string]string)
+	for _, step := range w.steps {
+		form, ok := step.content.(*Form)
+		if !ok {
+			continue
+		}
+		values := make(map[string]string)
+		for index := 0; index < form.GetFormItemCount(); index++ {
+			item := form.GetFormItem(index)
+			switch item := item.(type) {
+			case *InputField:
+				values[item.GetLabel()] = item.GetText()
+			case *DropDown:
+				_, option := item.GetCurrentOption()
+				values[item.GetLabel()] = option
+			case *Checkbox:
+				values[item.GetLabel()] = strconv.FormatBool(item.IsChecked())
+			}
+		}
+		data[step.title] = values
+	}
+	return data
+}
+
+// switchTo makes the step with the given index the current step and focuses
+// its content if the wizard had focus.
+func (w *Wizard) switchTo(index int) {
+	hasFocus := w.HasFocus()
+	w.current = index
+	w.pages.SwitchToPage(strconv.Itoa(index))
+	w.updateButtons()
+	if hasFocus && w.setFocus != nil {
+		w.setFocus(w.steps[index].content)
+	}
+	if w.changed != nil {
+		w.changed(index, w.steps[index].title)
+	}
+}
+
+// updateButtons sets the label of the Next button according to the current
+// step.
+func (w *Wizard) updateButtons() {
+	if w.current == len(w.steps)-1 {
+		w.next.SetLabel(w.finishLabel)
+	} else {
+		w.next.SetLabel(w.nextLabel)
+	}
+}
+
+// exitButton is called when the user leaves one of the buttons with the given
+// key.
+func (w *Wizard) exitButton(key tcell.Key) {
+	if w.setFocus == nil {
+		return
+	}
+	backVisible := w.current > 0
+	switch key {
+	case tcell.KeyEscape:
+		if w.cancel != nil {
+			w.cancel()
+		}
+	case tcell.KeyTab:
+		if w.back.HasFocus() {
+			w.setFocus(w.next)
+		} else if w.current < len(w.steps) {
+			w.setFocus(w.steps[w.current].content)
+		}
+	case tcell.KeyBacktab:
+		if w.next.HasFocus() && backVisible {
+			w.setFocus(w.back)
+		} else if w.current < len(w.steps) {
+			w.setFocus(w.steps[w.current].content)
+		}
+	}
+}
+
+// Draw draws this primitive onto the screen.
+func (w *Wizard) Draw(screen tcell.Screen) {
+	if !w.Box.DrawForSubclass(screen, w) {
+		return
+	}
+
+	x, y, width, height := w.GetInnerRect()
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	// Draw the stepper header.
+	pos := x
+	for index, step := range w.steps {
+		if pos >= x+width {
+			break
+		}
+		style := w.upcomingStyle
+		if index < w.current {
+			style = w.doneStyle
+		} else if index == w.current {
+			style = w.currentStyle
+		}
+		if index > 0 {
+			_, printed, _, _ := printWithStyle(screen, " › ", pos, y, 0, x+width-pos, AlignLeft, w.upcomingStyle, true)
+			pos += printed
+		}
+		_, printed, _, _ := printWithStyle(screen, strconv.Itoa(index+1)+". "+step.title, pos, y, 0, x+width-pos, AlignLeft, style, true)
+		pos += printed
+	}
+
+	// Draw the buttons.
+	buttonsY := y + height - 1
+	buttonX := x + width
+	for _, button := range []*Button{w.next, w.back} {
+		if button == w.back && w.current == 0 {
+			break
+		}
+		buttonWidth := TaggedStringWidth(button.GetLabel()) + 4
+		buttonX -= buttonWidth
+		if buttonX < x {
+			break
+		}
+		button.SetRect(buttonX, buttonsY, buttonWidth, 1)
+		button.Draw(screen)
+		buttonX--
+	}
+
+	// Draw the validation error.
+	if w.errorText != "" && height > 3 {
+		Print(screen, w.errorText, x, buttonsY-1, width, AlignLeft, w.errorColor)
+	}
+
+	// Draw the current step.
+	if height > 4 {
+		w.pages.SetRect(x, y+2, width, height-5)
+		w.pages.Draw(screen)
+	}
+}
+
+// Focus is called when this primitive receives focus.
+func (w *Wizard) Focus(delegate func(p Primitive)) {
+	w.setFocus = delegate
+	if w.current < len(w.steps) {
+		delegate(w.steps[w.current].content)
+		return
+	}
+	w.Box.Focus(delegate)
+}
+
+// HasFocus returns whether or not this primitive has focus.
+func (w *Wizard) HasFocus() bool {
+	if w.back.HasFocus() || w.next.HasFocus() {
+		return true
+	}
+	if w.current < len(w.steps) && w.steps[w.current].content.HasFocus() {
+		return true
+	}
+	return w.Box.HasFocus()
+}
+
+// containedPrimitives returns the buttons and the pages holding the step
+// contents. The Back button is omitted on the first step if visibleOnly is
+// true. See primitiveContainer.
+func (w *Wizard) containedPrimitives(visibleOnly bool) []Primitive {
+	if visibleOnly && w.current == 0 {
+		return []Primitive{w.next, w.pages}
+	}
+	return []Primitive{w.next, w.back, w.pages}
+}
+
+// InputHandler returns the handler for this primitive.
+func (w *Wizard) InputHandler() func(event *tcell.EventKey, setFocus func(p Primitive)) {
+	return w.WrapInputHandler(func(event *tcell.EventKey, setFocus func(p Primitive)) {
+		w.setFocus = setFocus
+		switch event.Key() {
+		case tcell.KeyCtrlN:
+			w.Next()
+			return
+		case tcell.KeyCtrlP:
+			w.Back()
+			return
+		}
+
+		// Pass the event on to the buttons.
+		for _, button := range []*Button{w.back, w.next} {
+			if button.HasFocus() {
+				if handler := button.InputHandler(); handler != nil {
+					handler(event, setFocus)
+				}
+				return
+			}
+		}
+
+		if w.current >= len(w.steps) {
+			return
+		}
+		if event.Key() == tcell.KeyEscape {
+			setFocus(w.next)
+			return
+		}
+		if handler := w.steps[w.current].content.InputHandler(); handler != nil {
+			handler(event, setFocus)
+		}
+	})
+}
+
+// MouseHandler returns the mouse handler for this primitive.
+func (w *Wizard) MouseHandler() func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+	return w.WrapMouseHandler(func(action MouseAction, event *tcell.EventMouse, setFocus func(p Primitive)) (consumed bool, capture Primitive) {
+		if !w.InRect(event.Position()) {
+			return false, nil
+		}
+		w.setFocus = setFocus
+
+		// Pass the event on to the buttons and the current step.
+		buttons := []*Button{w.next}
+		if w.current > 0 {
+			buttons = append(buttons, w.back)
+		}
+		for _, button := range buttons {
+			if consumed, capture = button.MouseHandler()(action, event, setFocus); consumed {
+				return
+			}
+		}
+		if len(w.steps) > 0 {
+			if consumed, capture = w.pages.MouseHandler()(action, event, setFocus); consumed {
+				return
+			}
+		}
+
+		// Clicking anywhere else focuses the current step.
+		if action == MouseLeftClick {
+			w.Focus(setFocus)
+			consumed = true
+		}
+		return
+	})
+}